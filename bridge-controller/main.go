@@ -2,10 +2,24 @@ package main
 
 import (
 	"context"
+	"flag"
 
 	log "github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+
 	"github.com/zmalik/kudo-bridge/bridge-controller/pkg/client"
 	"github.com/zmalik/kudo-bridge/bridge-controller/pkg/controller"
+	"github.com/zmalik/kudo-bridge/bridge-controller/pkg/kudobridge/bridge"
+	"github.com/zmalik/kudo-bridge/bridge-controller/pkg/webhook"
+)
+
+var (
+	controllerImage    string
+	controllerTemplate string
+	webhookPort        int
+	webhookCertDir     string
+	sharedController   bool
+	sharedAccount      string
 )
 
 func main() {
@@ -15,11 +29,35 @@ func main() {
 		log.Fatalf("failed to get kube client: %v", err)
 		return
 	}
-	cont := controller.NewController(clientSet)
+	var template *corev1.PodTemplateSpec
+	if controllerTemplate != "" {
+		template, err = bridge.LoadControllerTemplate(controllerTemplate)
+		if err != nil {
+			log.Fatalf("failed to load the crd-controller pod template: %v", err)
+			return
+		}
+	}
+	if webhookCertDir != "" {
+		server, err := webhook.NewServer(clientSet, webhookPort, webhookCertDir)
+		if err != nil {
+			log.Fatalf("failed to create the webhook server: %v", err)
+			return
+		}
+		go server.Run(make(chan struct{}))
+	}
+	cont := controller.NewController(clientSet, controllerImage, template, sharedController, sharedAccount)
 	cont.Run(context.Background())
 }
 
 func init() {
+	flag.StringVar(&controllerImage, "controller-image", bridge.DefaultControllerImage, "default image of the crd-controller")
+	flag.StringVar(&controllerTemplate, "controller-template", "", "YAML file of the cluster wide crd-controller pod template defaults")
+	flag.IntVar(&webhookPort, "webhook-port", 9443, "port of the webhook server")
+	flag.StringVar(&webhookCertDir, "webhook-cert-dir", "", "directory of the webhook serving certificates tls.crt and tls.key, the webhooks are disabled when empty")
+	flag.BoolVar(&sharedController, "shared-controller", false, "don't deploy a crd-controller per BridgeInstance, all of them are processed by the shared crd-controller")
+	flag.StringVar(&sharedAccount, "shared-controller-account", "kudo-system/kudo-bridge-crd-controller", "namespace/name of the ServiceAccount of the shared crd-controller, the RBAC of the BridgeInstances is bound to it")
+	flag.Parse()
+
 	customFormatter := new(log.TextFormatter)
 	customFormatter.TimestampFormat = "2006-01-02 15:04:05"
 	log.SetFormatter(customFormatter)
@@ -16,6 +16,7 @@ limitations under the License.
 package v1alpha1
 
 import (
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 )
@@ -26,7 +27,160 @@ type BridgeInstanceSpec struct {
 	KUDOOperator KUDOOperator `json:"kudoOperator,omitempty"`
 
 	//CRDSpec specifies the CRD to watch
+	// +kubebuilder:pruning:PreserveUnknownFields
 	CRDSpec unstructured.Unstructured `json:"crdSpec,omitempty"`
+
+	//PlaceholderSyntax specifies how the placeholders are written in CRDSpec, defaults to Sigil
+	PlaceholderSyntax PlaceholderSyntax `json:"placeholderSyntax,omitempty"`
+
+	//ParameterMappings specifies how the CRD fields, including the metadata, are mapped to the KUDO Operator parameters.
+	//When present, the mappings are used and the placeholders in CRDSpec are ignored.
+	ParameterMappings []ParameterMapping `json:"parameterMappings,omitempty"`
+
+	//StatusMappings specifies how the KUDO Instance status is reported in the CRD status
+	StatusMappings []StatusMapping `json:"statusMappings,omitempty"`
+
+	//Validations specifies the CEL rules the CRD objects must satisfy before their parameters are passed to KUDO
+	Validations []ValidationRule `json:"validations,omitempty"`
+
+	//Controller specifies a pod template merged into the CRD controller pod with strategic merge semantics,
+	//e.g. to set the image, resources, nodeSelector or securityContext of the crd-controller container
+	// +kubebuilder:pruning:PreserveUnknownFields
+	Controller *corev1.PodTemplateSpec `json:"controller,omitempty"`
+
+	//Suspend stops the CRD controller from installing or updating the KUDO Instances, the pending parameter
+	//changes are reported in the CRD objects status and applied once the bridge is resumed
+	Suspend bool `json:"suspend,omitempty"`
+
+	//DeletionPolicy specifies what happens to the KUDO Instance when its CRD object is deleted, defaults to Delete
+	DeletionPolicy DeletionPolicy `json:"deletionPolicy,omitempty"`
+
+	//CRSelector specifies the CRD objects processed by the bridge, all of them when not set.
+	//Several bridges of the same kind split the CRD objects with their selectors and priorities.
+	CRSelector *CRSelector `json:"crSelector,omitempty"`
+
+	//Priority specifies which bridge processes a CRD object selected by several bridges, the highest priority wins
+	Priority int32 `json:"priority,omitempty"`
+
+	//InstanceName specifies a Go template of the KUDO Instance name evaluated against the CRD object kind, name,
+	//namespace, group and version, e.g. {{ .Kind | lower }}-{{ .Name }}. Defaults to the CRD object name.
+	//The KUDO template functions are available.
+	InstanceName string `json:"instanceName,omitempty"`
+}
+
+// CRSelector selects the CRD objects of a bridge, an object is selected when it matches all the selectors
+type CRSelector struct {
+	//LabelSelector specifies the labels of the CRD objects
+	LabelSelector *metav1.LabelSelector `json:"labelSelector,omitempty"`
+	//NamespaceSelector specifies the labels of the namespace of the CRD objects, the cluster scoped objects don't match it
+	NamespaceSelector *metav1.LabelSelector `json:"namespaceSelector,omitempty"`
+}
+
+const (
+	// SuspendAnnotation suspends the KUDO Instance updates of a single CRD object when set to "true"
+	SuspendAnnotation = "kudobridge.dev/suspend"
+	// OperatorVersionAnnotation pins the KUDO Operator version of a single CRD object, within KUDOOperator.AllowedVersions
+	OperatorVersionAnnotation = "kudobridge.dev/operator-version"
+	// AppVersionAnnotation pins the KUDO Operator application version of a single CRD object, within
+	// KUDOOperator.AllowedAppVersions
+	AppVersionAnnotation = "kudobridge.dev/app-version"
+	// InstanceNameAnnotation records the name of the KUDO Instance of a CRD object, the Instance keeps its name
+	// when the InstanceName template of the bridge changes
+	InstanceNameAnnotation = "kudobridge.dev/instance-name"
+	// DeletionPolicyAnnotation records the DeletionPolicy of the bridge of a CRD object, it is applied when the
+	// object is deleted while no bridge selects it
+	DeletionPolicyAnnotation = "kudobridge.dev/deletion-policy"
+	// InstanceNamespaceAnnotation records the namespace of the KUDO Instance of a CRD object with its DeletionPolicy
+	InstanceNamespaceAnnotation = "kudobridge.dev/instance-namespace"
+)
+
+// InstanceFinalizer holds the deletion of a CRD object until the DeletionPolicy is applied to its KUDO Instance
+const InstanceFinalizer = "instance.bridge.kudo.dev"
+
+// DeletionPolicy defines what happens to the KUDO Instance of a deleted CRD object
+type DeletionPolicy string
+
+const (
+	// DeletionPolicyDelete deletes the KUDO Instance and its resources, the CRD object is removed once KUDO cleaned up
+	DeletionPolicyDelete DeletionPolicy = "Delete"
+	// DeletionPolicyOrphan deletes the KUDO Instance but keeps its resources, e.g. the StatefulSets and their volumes
+	DeletionPolicyOrphan DeletionPolicy = "Orphan"
+	// DeletionPolicyRetain keeps the KUDO Instance running and releases it from the CRD object
+	DeletionPolicyRetain DeletionPolicy = "Retain"
+)
+
+// PlaceholderSyntax defines how the KUDO Operator parameters are referenced in the CRDSpec
+type PlaceholderSyntax string
+
+const (
+	// PlaceholderSyntaxSigil references parameters as ${PARAM}, several placeholders can be combined in a string
+	// like ${CLUSTER}-${DC} and $${PARAM} is kept as the literal ${PARAM}
+	PlaceholderSyntaxSigil PlaceholderSyntax = "Sigil"
+	// PlaceholderSyntaxBare references parameters with values equal to the parameter name
+	PlaceholderSyntaxBare PlaceholderSyntax = "Bare"
+)
+
+// ParameterMapping maps a field of the watched CRD to a KUDO Operator parameter
+type ParameterMapping struct {
+	//From specifies the path of the CRD field, e.g. .spec.size or .metadata.annotations["kudo.dev/foo"]
+	From string `json:"from,omitempty"`
+	//Template specifies a Go template evaluated against the CRD object instead of From,
+	//e.g. {{ .spec.memory | mul 1024 }}Ki. The KUDO template functions are available.
+	Template string `json:"template,omitempty"`
+	//To specifies the KUDO Operator parameter name
+	To string `json:"to"`
+	//Default specifies the value used when the CRD field is not set or the template renders no value
+	Default *string `json:"default,omitempty"`
+	//Required specifies if the CRD field or the template value must be set when no default is provided
+	Required bool `json:"required,omitempty"`
+	//Reference specifies that the CRD field references a key of a Secret or ConfigMap, like secretKeyRef,
+	//the referenced value is passed to the parameter
+	Reference ReferenceKind `json:"reference,omitempty"`
+	//ReferenceNames specifies the names of the Secrets or ConfigMaps the CRD field can reference, the CRD controller
+	//is only allowed to read them
+	ReferenceNames []string `json:"referenceNames,omitempty"`
+}
+
+// ReferenceKind defines the kind of object referenced by a CRD field
+type ReferenceKind string
+
+const (
+	// ReferenceSecret references a Secret key in the namespace of the CRD object
+	ReferenceSecret ReferenceKind = "Secret"
+	// ReferenceConfigMap references a ConfigMap key in the namespace of the CRD object
+	ReferenceConfigMap ReferenceKind = "ConfigMap"
+)
+
+// StatusSource defines a well known value of the KUDO Instance status
+type StatusSource string
+
+const (
+	// StatusSourcePlan is the name of the active or last executed plan
+	StatusSourcePlan StatusSource = "Plan"
+	// StatusSourcePlanStatus is the status of the active or last executed plan
+	StatusSourcePlanStatus StatusSource = "PlanStatus"
+	// StatusSourcePhase is the name of the active or last executed phase of the plan
+	StatusSourcePhase StatusSource = "Phase"
+	// StatusSourceLastError is the message of the last failed plan
+	StatusSourceLastError StatusSource = "LastError"
+)
+
+// StatusMapping maps a value of the KUDO Instance to a field of the CRD status
+type StatusMapping struct {
+	//From specifies the KUDO Instance value, either a StatusSource or the path of an Instance field,
+	//e.g. .status.planStatus.deploy.status
+	From string `json:"from"`
+	//To specifies the path of the CRD status field, e.g. .status.phase
+	To string `json:"to"`
+}
+
+// ValidationRule defines a CEL expression evaluated against the CRD object
+type ValidationRule struct {
+	//Rule specifies the CEL expression, the CRD object is bound to self,
+	//e.g. self.spec.size >= 3 || self.spec.allowSingleNode
+	Rule string `json:"rule"`
+	//Message specifies the message reported when the rule is not satisfied, defaults to the rule
+	Message string `json:"message,omitempty"`
 }
 
 // KUDOOperator defines the KUDO Operator reference definition
@@ -41,17 +195,68 @@ type KUDOOperator struct {
 	Version string `json:"version,omitempty"`
 	//AppVersion specifies the KUDO Operator Application Version
 	AppVersion string `json:"appVersion,omitempty"`
+	//AllowedVersions specifies the semver range of the versions the CRD objects can pin with the
+	//kudobridge.dev/operator-version annotation, e.g. >=0.1.0, <0.3.0. The annotation is rejected when not set.
+	AllowedVersions string `json:"allowedVersions,omitempty"`
+	//AllowedAppVersions specifies the semver range of the application versions the CRD objects can pin with the
+	//kudobridge.dev/app-version annotation. The annotation is rejected when not set.
+	AllowedAppVersions string `json:"allowedAppVersions,omitempty"`
 }
 
 // BridgeInstanceStatus defines the observed state of Instance
 type BridgeInstanceStatus struct {
 	Status string `json:"bridgeInstanceStatus,omitempty"`
+
+	//ObservedGeneration specifies the generation of the BridgeInstance reported by the conditions
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	//Conditions specifies the latest observations of the BridgeInstance state
+	Conditions []Condition `json:"conditions,omitempty"`
+}
+
+// ConditionType defines the aspect of the BridgeInstance reported by a condition
+type ConditionType string
+
+const (
+	// ConditionCRDResolved reports if the watched CRD is served by the API server
+	ConditionCRDResolved ConditionType = "CRDResolved"
+	// ConditionCRDWatched reports if the CRD controller watches the CRD objects, it waits for the CRD to be established
+	// and to serve the watched version
+	ConditionCRDWatched ConditionType = "CRDWatched"
+	// ConditionRBACReady reports if the ServiceAccount and the roles of the CRD controller are created
+	ConditionRBACReady ConditionType = "RBACReady"
+	// ConditionControllerDeployed reports if the CRD controller Deployment is created
+	ConditionControllerDeployed ConditionType = "ControllerDeployed"
+	// ConditionControllerAvailable reports if the CRD controller Deployment is available
+	ConditionControllerAvailable ConditionType = "ControllerAvailable"
+	// ConditionOperatorResolved reports if the KUDO Operator is found in the repository or in the cluster
+	ConditionOperatorResolved ConditionType = "OperatorResolved"
+	// ConditionSuspended reports if the KUDO Instance updates are suspended
+	ConditionSuspended ConditionType = "Suspended"
+)
+
+// Condition mirrors the metav1.Condition of the newer Kubernetes API versions
+type Condition struct {
+	//Type specifies the type of the condition
+	Type ConditionType `json:"type"`
+	//Status specifies the status of the condition, one of True, False or Unknown
+	Status metav1.ConditionStatus `json:"status"`
+	//ObservedGeneration specifies the generation of the BridgeInstance the condition was set for
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+	//LastTransitionTime specifies the last time the condition changed its status
+	LastTransitionTime metav1.Time `json:"lastTransitionTime"`
+	//Reason specifies a CamelCase reason of the last transition
+	Reason string `json:"reason"`
+	//Message specifies a human readable message of the last transition
+	Message string `json:"message"`
 }
 
 // +genclient
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 // Instance is the Schema for the instances API.
 // +k8s:openapi-gen=true
+// +kubebuilder:subresource:status
+// +kubebuilder:storageversion
 type BridgeInstance struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata,omitempty"`
@@ -0,0 +1,84 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ClusterBridgeInstanceSpec defines the desired state of a ClusterBridgeInstance
+type ClusterBridgeInstanceSpec struct {
+	BridgeInstanceSpec `json:",inline"`
+
+	//TargetNamespace specifies the namespace of the KUDO Instances and of the CRD controller
+	TargetNamespace string `json:"targetNamespace"`
+}
+
+// +genclient
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// ClusterBridgeInstance bridges a cluster scoped CRD to KUDO, the KUDO Instances are created in the TargetNamespace.
+// +k8s:openapi-gen=true
+// +kubebuilder:resource:scope=Cluster
+// +kubebuilder:subresource:status
+type ClusterBridgeInstance struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ClusterBridgeInstanceSpec `json:"spec,omitempty"`
+	Status BridgeInstanceStatus      `json:"status,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// ClusterBridgeInstanceList contains a list of ClusterBridgeInstance.
+type ClusterBridgeInstanceList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ClusterBridgeInstance `json:"items"`
+}
+
+// BridgeInstance returns the BridgeInstance processed for the ClusterBridgeInstance, it lives in the TargetNamespace
+// and keeps the kind and UID of the ClusterBridgeInstance for the owner references
+func (c *ClusterBridgeInstance) BridgeInstance() *BridgeInstance {
+	bi := &BridgeInstance{
+		TypeMeta:   c.TypeMeta,
+		ObjectMeta: *c.ObjectMeta.DeepCopy(),
+		Spec:       *c.Spec.BridgeInstanceSpec.DeepCopy(),
+		Status:     *c.Status.DeepCopy(),
+	}
+	bi.SetNamespace(c.Spec.TargetNamespace)
+	return bi
+}
+
+// ClusterBridgeInstance returns the ClusterBridgeInstance a BridgeInstance was returned for, e.g. to write its updates
+func (bi *BridgeInstance) ClusterBridgeInstance() *ClusterBridgeInstance {
+	cbi := &ClusterBridgeInstance{
+		TypeMeta:   bi.TypeMeta,
+		ObjectMeta: *bi.ObjectMeta.DeepCopy(),
+		Spec: ClusterBridgeInstanceSpec{
+			BridgeInstanceSpec: *bi.Spec.DeepCopy(),
+			TargetNamespace:    bi.GetNamespace(),
+		},
+		Status: *bi.Status.DeepCopy(),
+	}
+	cbi.SetNamespace("")
+	return cbi
+}
+
+// IsCluster returns true when the BridgeInstance was returned by ClusterBridgeInstance.BridgeInstance
+func (bi *BridgeInstance) IsCluster() bool {
+	return bi.Kind == ClusterBridgeInstanceKind
+}
@@ -35,6 +35,9 @@ const (
 
 	// CsiDriverResourcePlural is the plural name of the CSIDriver resource
 	KUDOBridgeResourcePlural string = "bridges"
+
+	// ClusterBridgeInstanceKind is the kind of the cluster scoped BridgeInstances
+	ClusterBridgeInstanceKind string = "ClusterBridgeInstance"
 )
 
 var (
@@ -58,6 +61,8 @@ func AddKnownTypes(scheme *runtime.Scheme) error {
 	scheme.AddKnownTypes(SchemeGroupVersion,
 		&BridgeInstance{},
 		&BridgeInstanceList{},
+		&ClusterBridgeInstance{},
+		&ClusterBridgeInstanceList{},
 	)
 
 	metav1.AddToGroupVersion(scheme, SchemeGroupVersion)
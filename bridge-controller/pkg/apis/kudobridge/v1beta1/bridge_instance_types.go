@@ -0,0 +1,238 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// BridgeInstanceSpec defines the desired state of Instance.
+type BridgeInstanceSpec struct {
+	//KUDOOperator specifies the KUDO Operator
+	KUDOOperator KUDOOperator `json:"kudoOperator"`
+
+	//Target specifies the custom resource to watch
+	Target TargetResource `json:"target"`
+
+	//Template specifies the custom resource with the placeholders of the KUDO Operator parameters,
+	//its apiVersion and kind are set from Target
+	// +kubebuilder:pruning:PreserveUnknownFields
+	Template *runtime.RawExtension `json:"template,omitempty"`
+
+	//Mappings specifies how the custom resource is mapped to the KUDO Instance
+	Mappings Mappings `json:"mappings,omitempty"`
+
+	//Validations specifies the CEL rules the custom resources must satisfy before their parameters are passed to KUDO
+	Validations []ValidationRule `json:"validations,omitempty"`
+
+	//Controller specifies a pod template merged into the CRD controller pod with strategic merge semantics,
+	//e.g. to set the image, resources, nodeSelector or securityContext of the crd-controller container
+	// +kubebuilder:pruning:PreserveUnknownFields
+	Controller *corev1.PodTemplateSpec `json:"controller,omitempty"`
+
+	//Suspend stops the CRD controller from installing or updating the KUDO Instances, the pending parameter
+	//changes are reported in the custom resources status and applied once the bridge is resumed
+	Suspend bool `json:"suspend,omitempty"`
+
+	//DeletionPolicy specifies what happens to the KUDO Instance when its custom resource is deleted, defaults to Delete
+	DeletionPolicy DeletionPolicy `json:"deletionPolicy,omitempty"`
+
+	//CRSelector specifies the custom resources processed by the bridge, all of them when not set.
+	//Several bridges of the same target split the custom resources with their selectors and priorities.
+	CRSelector *CRSelector `json:"crSelector,omitempty"`
+
+	//Priority specifies which bridge processes a custom resource selected by several bridges, the highest priority wins
+	Priority int32 `json:"priority,omitempty"`
+
+	//InstanceName specifies a Go template of the KUDO Instance name evaluated against the custom resource kind, name,
+	//namespace, group and version, e.g. {{ .Kind | lower }}-{{ .Name }}. Defaults to the custom resource name.
+	//The KUDO template functions are available.
+	InstanceName string `json:"instanceName,omitempty"`
+}
+
+// CRSelector selects the custom resources of a bridge, a custom resource is selected when it matches all the selectors
+type CRSelector struct {
+	//LabelSelector specifies the labels of the custom resources
+	LabelSelector *metav1.LabelSelector `json:"labelSelector,omitempty"`
+	//NamespaceSelector specifies the labels of the namespace of the custom resources, the cluster scoped ones don't match it
+	NamespaceSelector *metav1.LabelSelector `json:"namespaceSelector,omitempty"`
+}
+
+// DeletionPolicy defines what happens to the KUDO Instance of a deleted custom resource
+type DeletionPolicy string
+
+const (
+	// DeletionPolicyDelete deletes the KUDO Instance and its resources, the custom resource is removed once KUDO cleaned up
+	DeletionPolicyDelete DeletionPolicy = "Delete"
+	// DeletionPolicyOrphan deletes the KUDO Instance but keeps its resources, e.g. the StatefulSets and their volumes
+	DeletionPolicyOrphan DeletionPolicy = "Orphan"
+	// DeletionPolicyRetain keeps the KUDO Instance running and releases it from the custom resource
+	DeletionPolicyRetain DeletionPolicy = "Retain"
+)
+
+// TargetResource defines the group, version and kind of the watched custom resource
+type TargetResource struct {
+	//Group specifies the API group of the custom resource
+	Group string `json:"group"`
+	//Version specifies the API version of the custom resource
+	Version string `json:"version"`
+	//Kind specifies the kind of the custom resource
+	Kind string `json:"kind"`
+}
+
+// Mappings defines how the custom resource is mapped to the KUDO Instance
+type Mappings struct {
+	//PlaceholderSyntax specifies how the placeholders are written in Template, defaults to Sigil
+	PlaceholderSyntax PlaceholderSyntax `json:"placeholderSyntax,omitempty"`
+	//Parameters specifies how the custom resource fields are mapped to the KUDO Operator parameters.
+	//When present, the mappings are used and the placeholders in Template are ignored.
+	Parameters []ParameterMapping `json:"parameters,omitempty"`
+	//Status specifies how the KUDO Instance status is reported in the custom resource status
+	Status []StatusMapping `json:"status,omitempty"`
+}
+
+// PlaceholderSyntax defines how the KUDO Operator parameters are referenced in the Template
+type PlaceholderSyntax string
+
+const (
+	// PlaceholderSyntaxSigil references parameters as ${PARAM}, several placeholders can be combined in a string
+	// like ${CLUSTER}-${DC} and $${PARAM} is kept as the literal ${PARAM}
+	PlaceholderSyntaxSigil PlaceholderSyntax = "Sigil"
+	// PlaceholderSyntaxBare references parameters with values equal to the parameter name
+	PlaceholderSyntaxBare PlaceholderSyntax = "Bare"
+)
+
+// ParameterMapping maps a field of the custom resource to a KUDO Operator parameter
+type ParameterMapping struct {
+	//From specifies the path of the custom resource field, e.g. .spec.size or .metadata.annotations["kudo.dev/foo"]
+	From string `json:"from,omitempty"`
+	//Template specifies a Go template evaluated against the custom resource instead of From,
+	//e.g. {{ .spec.memory | mul 1024 }}Ki. The KUDO template functions are available.
+	Template string `json:"template,omitempty"`
+	//To specifies the KUDO Operator parameter name
+	To string `json:"to"`
+	//Default specifies the value used when the custom resource field is not set or the template renders no value
+	Default *string `json:"default,omitempty"`
+	//Required specifies if the custom resource field or the template value must be set when no default is provided
+	Required bool `json:"required,omitempty"`
+	//Reference specifies that the custom resource field references a key of a Secret or ConfigMap, like secretKeyRef,
+	//the referenced value is passed to the parameter
+	Reference ReferenceKind `json:"reference,omitempty"`
+	//ReferenceNames specifies the names of the Secrets or ConfigMaps the custom resource field can reference, the CRD controller
+	//is only allowed to read them
+	ReferenceNames []string `json:"referenceNames,omitempty"`
+}
+
+// ReferenceKind defines the kind of object referenced by a custom resource field
+type ReferenceKind string
+
+const (
+	// ReferenceSecret references a Secret key in the namespace of the custom resource
+	ReferenceSecret ReferenceKind = "Secret"
+	// ReferenceConfigMap references a ConfigMap key in the namespace of the custom resource
+	ReferenceConfigMap ReferenceKind = "ConfigMap"
+)
+
+// StatusMapping maps a value of the KUDO Instance to a field of the custom resource status
+type StatusMapping struct {
+	//From specifies the KUDO Instance value, either Plan, PlanStatus, Phase, LastError or the path of an Instance field,
+	//e.g. .status.planStatus.deploy.status
+	From string `json:"from"`
+	//To specifies the path of the custom resource status field, e.g. .status.phase
+	To string `json:"to"`
+}
+
+// ValidationRule defines a CEL expression evaluated against the custom resource
+type ValidationRule struct {
+	//Rule specifies the CEL expression, the custom resource is bound to self,
+	//e.g. self.spec.size >= 3 || self.spec.allowSingleNode
+	Rule string `json:"rule"`
+	//Message specifies the message reported when the rule is not satisfied, defaults to the rule
+	Message string `json:"message,omitempty"`
+}
+
+// KUDOOperator defines the KUDO Operator reference definition
+type KUDOOperator struct {
+	//Package specifies the KUDO package name
+	Package string `json:"package"`
+	//KUDORepository specifies the KUDO Repository URL
+	KUDORepository string `json:"repository,omitempty"`
+	//InClusterOperator is used to resolve incluster operator
+	InClusterOperator bool `json:"inClusterOperator,omitempty"`
+	//Version specifies the KUDO Operator Version
+	Version string `json:"version,omitempty"`
+	//AppVersion specifies the KUDO Operator Application Version
+	AppVersion string `json:"appVersion,omitempty"`
+	//AllowedVersions specifies the semver range of the versions the custom resources can pin with the
+	//kudobridge.dev/operator-version annotation, e.g. >=0.1.0, <0.3.0. The annotation is rejected when not set.
+	AllowedVersions string `json:"allowedVersions,omitempty"`
+	//AllowedAppVersions specifies the semver range of the application versions the custom resources can pin with the
+	//kudobridge.dev/app-version annotation. The annotation is rejected when not set.
+	AllowedAppVersions string `json:"allowedAppVersions,omitempty"`
+}
+
+// BridgeInstanceStatus defines the observed state of Instance
+type BridgeInstanceStatus struct {
+	//ObservedGeneration specifies the generation of the BridgeInstance reported by the conditions
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	//Conditions specifies the latest observations of the BridgeInstance state
+	Conditions []Condition `json:"conditions,omitempty"`
+}
+
+// Condition mirrors the metav1.Condition of the newer Kubernetes API versions
+type Condition struct {
+	//Type specifies the type of the condition, e.g. CRDResolved, RBACReady, ControllerDeployed,
+	//ControllerAvailable or OperatorResolved
+	Type string `json:"type"`
+	//Status specifies the status of the condition, one of True, False or Unknown
+	Status metav1.ConditionStatus `json:"status"`
+	//ObservedGeneration specifies the generation of the BridgeInstance the condition was set for
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+	//LastTransitionTime specifies the last time the condition changed its status
+	LastTransitionTime metav1.Time `json:"lastTransitionTime"`
+	//Reason specifies a CamelCase reason of the last transition
+	Reason string `json:"reason"`
+	//Message specifies a human readable message of the last transition
+	Message string `json:"message"`
+}
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// BridgeInstance is the Schema for the bridgeinstances API.
+// +k8s:openapi-gen=true
+// +kubebuilder:subresource:status
+type BridgeInstance struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   BridgeInstanceSpec   `json:"spec,omitempty"`
+	Status BridgeInstanceStatus `json:"status,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// BridgeInstanceList contains a list of BridgeInstance.
+type BridgeInstanceList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []BridgeInstance `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(AddKnownTypes)
+}
@@ -0,0 +1,188 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/conversion"
+
+	"github.com/zmalik/kudo-bridge/bridge-controller/pkg/apis/kudobridge/v1alpha1"
+)
+
+// v1alpha1StatusAnnotation keeps the v1alpha1 bridgeInstanceStatus which has no v1beta1 equivalent
+const v1alpha1StatusAnnotation = "kudobridge.dev/v1alpha1-status"
+
+// ConvertTo converts the BridgeInstance to the v1alpha1 storage version
+func (src *BridgeInstance) ConvertTo(dstRaw conversion.Hub) error {
+	dst, ok := dstRaw.(*v1alpha1.BridgeInstance)
+	if !ok {
+		return fmt.Errorf("unexpected conversion target %T", dstRaw)
+	}
+	dst.ObjectMeta = *src.ObjectMeta.DeepCopy()
+	if status, ok := dst.Annotations[v1alpha1StatusAnnotation]; ok {
+		dst.Status.Status = status
+		delete(dst.Annotations, v1alpha1StatusAnnotation)
+		if len(dst.Annotations) == 0 {
+			dst.Annotations = nil
+		}
+	}
+
+	// the v1alpha1 crdSpec holds the target group, version and kind
+	crdSpec := map[string]interface{}{}
+	if src.Spec.Template != nil && len(src.Spec.Template.Raw) > 0 {
+		if err := json.Unmarshal(src.Spec.Template.Raw, &crdSpec); err != nil {
+			return fmt.Errorf("invalid template of %s/%s: %v", src.Namespace, src.Name, err)
+		}
+	}
+	dst.Spec.CRDSpec = unstructured.Unstructured{Object: crdSpec}
+	dst.Spec.CRDSpec.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   src.Spec.Target.Group,
+		Version: src.Spec.Target.Version,
+		Kind:    src.Spec.Target.Kind,
+	})
+
+	dst.Spec.KUDOOperator = v1alpha1.KUDOOperator(src.Spec.KUDOOperator)
+	dst.Spec.PlaceholderSyntax = v1alpha1.PlaceholderSyntax(src.Spec.Mappings.PlaceholderSyntax)
+	dst.Spec.ParameterMappings = nil
+	for _, m := range src.Spec.Mappings.Parameters {
+		dst.Spec.ParameterMappings = append(dst.Spec.ParameterMappings, v1alpha1.ParameterMapping{
+			From:           m.From,
+			Template:       m.Template,
+			To:             m.To,
+			Default:        m.Default,
+			Required:       m.Required,
+			Reference:      v1alpha1.ReferenceKind(m.Reference),
+			ReferenceNames: m.ReferenceNames,
+		})
+	}
+	dst.Spec.StatusMappings = nil
+	for _, m := range src.Spec.Mappings.Status {
+		dst.Spec.StatusMappings = append(dst.Spec.StatusMappings, v1alpha1.StatusMapping(m))
+	}
+	dst.Spec.Validations = nil
+	for _, r := range src.Spec.Validations {
+		dst.Spec.Validations = append(dst.Spec.Validations, v1alpha1.ValidationRule(r))
+	}
+	dst.Spec.Controller = src.Spec.Controller
+	dst.Spec.Suspend = src.Spec.Suspend
+	dst.Spec.DeletionPolicy = v1alpha1.DeletionPolicy(src.Spec.DeletionPolicy)
+	dst.Spec.CRSelector = nil
+	if src.Spec.CRSelector != nil {
+		selector := v1alpha1.CRSelector(*src.Spec.CRSelector)
+		dst.Spec.CRSelector = &selector
+	}
+	dst.Spec.Priority = src.Spec.Priority
+	dst.Spec.InstanceName = src.Spec.InstanceName
+
+	dst.Status.ObservedGeneration = src.Status.ObservedGeneration
+	dst.Status.Conditions = nil
+	for _, c := range src.Status.Conditions {
+		dst.Status.Conditions = append(dst.Status.Conditions, v1alpha1.Condition{
+			Type:               v1alpha1.ConditionType(c.Type),
+			Status:             c.Status,
+			ObservedGeneration: c.ObservedGeneration,
+			LastTransitionTime: c.LastTransitionTime,
+			Reason:             c.Reason,
+			Message:            c.Message,
+		})
+	}
+	return nil
+}
+
+// ConvertFrom converts the v1alpha1 storage version to the BridgeInstance
+func (dst *BridgeInstance) ConvertFrom(srcRaw conversion.Hub) error {
+	src, ok := srcRaw.(*v1alpha1.BridgeInstance)
+	if !ok {
+		return fmt.Errorf("unexpected conversion source %T", srcRaw)
+	}
+	dst.ObjectMeta = *src.ObjectMeta.DeepCopy()
+	if src.Status.Status != "" {
+		if dst.Annotations == nil {
+			dst.Annotations = make(map[string]string)
+		}
+		dst.Annotations[v1alpha1StatusAnnotation] = src.Status.Status
+	}
+
+	gvk := src.Spec.CRDSpec.GroupVersionKind()
+	dst.Spec.Target = TargetResource{
+		Group:   gvk.Group,
+		Version: gvk.Version,
+		Kind:    gvk.Kind,
+	}
+	dst.Spec.Template = nil
+	if src.Spec.CRDSpec.Object != nil {
+		template := src.Spec.CRDSpec.DeepCopy().Object
+		delete(template, "apiVersion")
+		delete(template, "kind")
+		if len(template) > 0 {
+			raw, err := json.Marshal(template)
+			if err != nil {
+				return err
+			}
+			dst.Spec.Template = &runtime.RawExtension{Raw: raw}
+		}
+	}
+
+	dst.Spec.KUDOOperator = KUDOOperator(src.Spec.KUDOOperator)
+	dst.Spec.Mappings = Mappings{PlaceholderSyntax: PlaceholderSyntax(src.Spec.PlaceholderSyntax)}
+	for _, m := range src.Spec.ParameterMappings {
+		dst.Spec.Mappings.Parameters = append(dst.Spec.Mappings.Parameters, ParameterMapping{
+			From:           m.From,
+			Template:       m.Template,
+			To:             m.To,
+			Default:        m.Default,
+			Required:       m.Required,
+			Reference:      ReferenceKind(m.Reference),
+			ReferenceNames: m.ReferenceNames,
+		})
+	}
+	for _, m := range src.Spec.StatusMappings {
+		dst.Spec.Mappings.Status = append(dst.Spec.Mappings.Status, StatusMapping(m))
+	}
+	dst.Spec.Validations = nil
+	for _, r := range src.Spec.Validations {
+		dst.Spec.Validations = append(dst.Spec.Validations, ValidationRule(r))
+	}
+	dst.Spec.Controller = src.Spec.Controller
+	dst.Spec.Suspend = src.Spec.Suspend
+	dst.Spec.DeletionPolicy = DeletionPolicy(src.Spec.DeletionPolicy)
+	dst.Spec.CRSelector = nil
+	if src.Spec.CRSelector != nil {
+		selector := CRSelector(*src.Spec.CRSelector)
+		dst.Spec.CRSelector = &selector
+	}
+	dst.Spec.Priority = src.Spec.Priority
+	dst.Spec.InstanceName = src.Spec.InstanceName
+
+	dst.Status.ObservedGeneration = src.Status.ObservedGeneration
+	dst.Status.Conditions = nil
+	for _, c := range src.Status.Conditions {
+		dst.Status.Conditions = append(dst.Status.Conditions, Condition{
+			Type:               string(c.Type),
+			Status:             c.Status,
+			ObservedGeneration: c.ObservedGeneration,
+			LastTransitionTime: c.LastTransitionTime,
+			Reason:             c.Reason,
+			Message:            c.Message,
+		})
+	}
+	return nil
+}
@@ -0,0 +1,277 @@
+package v1beta1
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/zmalik/kudo-bridge/bridge-controller/pkg/apis/kudobridge/v1alpha1"
+)
+
+var transitionTime = metav1.NewTime(time.Date(2020, 6, 1, 12, 0, 0, 0, time.UTC))
+
+func stringPtr(s string) *string {
+	return &s
+}
+
+func alphaBridgeInstance() *v1alpha1.BridgeInstance {
+	return &v1alpha1.BridgeInstance{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "redis",
+			Namespace:   "default",
+			Labels:      map[string]string{"group": "kudobridge.dev", "kind": "Redis", "version": "v1"},
+			Annotations: map[string]string{"team": "storage"},
+		},
+		Spec: v1alpha1.BridgeInstanceSpec{
+			KUDOOperator: v1alpha1.KUDOOperator{
+				Package:            "redis",
+				KUDORepository:     "community",
+				Version:            "0.2.0",
+				AppVersion:         "5.0.1",
+				AllowedVersions:    ">= 0.2.0",
+				AllowedAppVersions: "~5.0",
+			},
+			CRDSpec: unstructured.Unstructured{Object: map[string]interface{}{
+				"apiVersion": "kudobridge.dev/v1",
+				"kind":       "Redis",
+				"spec": map[string]interface{}{
+					"replicas": "${REPLICAS}",
+					"memory":   "${MEMORY}",
+				},
+			}},
+			PlaceholderSyntax: v1alpha1.PlaceholderSyntaxSigil,
+			ParameterMappings: []v1alpha1.ParameterMapping{
+				{From: `.metadata.labels["team"]`, To: "OWNER_TEAM", Default: stringPtr("none"), Required: true},
+				{Template: "{{ .Name }}-cluster", To: "CLUSTER_NAME"},
+				{From: ".spec.passwordSecret", To: "PASSWORD", Reference: v1alpha1.ReferenceSecret, ReferenceNames: []string{"redis-password"}},
+			},
+			StatusMappings: []v1alpha1.StatusMapping{
+				{From: string(v1alpha1.StatusSourcePhase), To: ".status.phase"},
+			},
+			Validations: []v1alpha1.ValidationRule{
+				{Rule: ".spec.replicas >= 1", Message: "at least one replica"},
+			},
+			Controller: &corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{ServiceAccountName: "bridge"},
+			},
+			Suspend:        true,
+			DeletionPolicy: v1alpha1.DeletionPolicyOrphan,
+			CRSelector: &v1alpha1.CRSelector{
+				LabelSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"tier": "cache"}},
+			},
+			Priority:     10,
+			InstanceName: "{{ .Namespace }}-{{ .Name }}",
+		},
+		Status: v1alpha1.BridgeInstanceStatus{
+			Status:             "CRD Controller deployed",
+			ObservedGeneration: 3,
+			Conditions: []v1alpha1.Condition{
+				{
+					Type:               v1alpha1.ConditionCRDResolved,
+					Status:             metav1.ConditionTrue,
+					ObservedGeneration: 3,
+					LastTransitionTime: transitionTime,
+					Reason:             "Resolved",
+					Message:            "",
+				},
+				{
+					Type:               v1alpha1.ConditionControllerAvailable,
+					Status:             metav1.ConditionFalse,
+					ObservedGeneration: 2,
+					LastTransitionTime: transitionTime,
+					Reason:             "Progressing",
+					Message:            "waiting for the crd-controller",
+				},
+			},
+		},
+	}
+}
+
+func betaBridgeInstance() *BridgeInstance {
+	return &BridgeInstance{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "redis",
+			Namespace:   "default",
+			Labels:      map[string]string{"group": "kudobridge.dev", "kind": "Redis", "version": "v1"},
+			Annotations: map[string]string{"team": "storage"},
+		},
+		Spec: BridgeInstanceSpec{
+			KUDOOperator: KUDOOperator{
+				Package:           "redis",
+				InClusterOperator: true,
+				Version:           "0.2.0",
+			},
+			Target:   TargetResource{Group: "kudobridge.dev", Version: "v1", Kind: "Redis"},
+			Template: &runtime.RawExtension{Raw: []byte(`{"spec":{"memory":"$MEMORY","replicas":"$REPLICAS"}}`)},
+			Mappings: Mappings{
+				PlaceholderSyntax: PlaceholderSyntaxBare,
+				Parameters: []ParameterMapping{
+					{From: ".spec.size", To: "SIZE", Default: stringPtr("1")},
+					{From: ".spec.config", To: "CONFIG", Reference: ReferenceConfigMap, ReferenceNames: []string{"redis-config", "redis-extra"}},
+				},
+				Status: []StatusMapping{
+					{From: "PlanStatus", To: ".status.plan"},
+				},
+			},
+			Validations:    []ValidationRule{{Rule: ".spec.size <= 5"}},
+			DeletionPolicy: DeletionPolicyRetain,
+			CRSelector: &CRSelector{
+				NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"env": "prod"}},
+			},
+			Priority:     -1,
+			InstanceName: "redis-{{ .Name }}",
+		},
+		Status: BridgeInstanceStatus{
+			ObservedGeneration: 7,
+			Conditions: []Condition{
+				{
+					Type:               string(v1alpha1.ConditionSuspended),
+					Status:             metav1.ConditionFalse,
+					ObservedGeneration: 7,
+					LastTransitionTime: transitionTime,
+					Reason:             "Resumed",
+					Message:            "",
+				},
+			},
+		},
+	}
+}
+
+func TestConvertAlphaRoundTrip(t *testing.T) {
+	tests := []struct {
+		name string
+		in   *v1alpha1.BridgeInstance
+	}{
+		{name: "all the fields", in: alphaBridgeInstance()},
+		{
+			name: "without status",
+			in: func() *v1alpha1.BridgeInstance {
+				bi := alphaBridgeInstance()
+				bi.Status = v1alpha1.BridgeInstanceStatus{}
+				return bi
+			}(),
+		},
+		{
+			name: "without annotations",
+			in: func() *v1alpha1.BridgeInstance {
+				bi := alphaBridgeInstance()
+				bi.Annotations = nil
+				return bi
+			}(),
+		},
+		{
+			name: "only the target in crdSpec",
+			in: func() *v1alpha1.BridgeInstance {
+				bi := alphaBridgeInstance()
+				bi.Spec.CRDSpec = unstructured.Unstructured{Object: map[string]interface{}{
+					"apiVersion": "kudobridge.dev/v1",
+					"kind":       "Redis",
+				}}
+				return bi
+			}(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			beta := &BridgeInstance{}
+			if err := beta.ConvertFrom(tt.in.DeepCopy()); err != nil {
+				t.Fatalf("ConvertFrom: %v", err)
+			}
+			if tt.in.Status.Status != "" && beta.Annotations[v1alpha1StatusAnnotation] != tt.in.Status.Status {
+				t.Errorf("annotation %s = %q, want %q", v1alpha1StatusAnnotation, beta.Annotations[v1alpha1StatusAnnotation], tt.in.Status.Status)
+			}
+
+			got := &v1alpha1.BridgeInstance{}
+			if err := beta.ConvertTo(got); err != nil {
+				t.Fatalf("ConvertTo: %v", err)
+			}
+			if _, ok := got.Annotations[v1alpha1StatusAnnotation]; ok {
+				t.Errorf("annotation %s is left in the v1alpha1 object", v1alpha1StatusAnnotation)
+			}
+			if !reflect.DeepEqual(got, tt.in) {
+				t.Errorf("round trip = %#v, want %#v", got, tt.in)
+			}
+		})
+	}
+}
+
+func TestConvertBetaRoundTrip(t *testing.T) {
+	tests := []struct {
+		name string
+		in   *BridgeInstance
+	}{
+		{name: "all the fields", in: betaBridgeInstance()},
+		{
+			name: "with the v1alpha1 status annotation",
+			in: func() *BridgeInstance {
+				bi := betaBridgeInstance()
+				bi.Annotations[v1alpha1StatusAnnotation] = "CRD Controller deployed"
+				return bi
+			}(),
+		},
+		{
+			name: "only the v1alpha1 status annotation",
+			in: func() *BridgeInstance {
+				bi := betaBridgeInstance()
+				bi.Annotations = map[string]string{v1alpha1StatusAnnotation: "CRD Controller deployed"}
+				return bi
+			}(),
+		},
+		{
+			name: "without template and status",
+			in: func() *BridgeInstance {
+				bi := betaBridgeInstance()
+				bi.Spec.Template = nil
+				bi.Status = BridgeInstanceStatus{}
+				return bi
+			}(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			alpha := &v1alpha1.BridgeInstance{}
+			if err := tt.in.DeepCopy().ConvertTo(alpha); err != nil {
+				t.Fatalf("ConvertTo: %v", err)
+			}
+			if status, ok := tt.in.Annotations[v1alpha1StatusAnnotation]; ok {
+				if alpha.Status.Status != status {
+					t.Errorf("bridgeInstanceStatus = %q, want %q", alpha.Status.Status, status)
+				}
+				if _, ok := alpha.Annotations[v1alpha1StatusAnnotation]; ok {
+					t.Errorf("annotation %s is left in the v1alpha1 object", v1alpha1StatusAnnotation)
+				}
+			}
+
+			got := &BridgeInstance{}
+			if err := got.ConvertFrom(alpha); err != nil {
+				t.Fatalf("ConvertFrom: %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.in) {
+				t.Errorf("round trip = %#v, want %#v", got, tt.in)
+			}
+		})
+	}
+}
+
+func TestConvertToRemovesEmptyAnnotations(t *testing.T) {
+	beta := betaBridgeInstance()
+	beta.Annotations = map[string]string{v1alpha1StatusAnnotation: "CRD Controller deployed"}
+
+	alpha := &v1alpha1.BridgeInstance{}
+	if err := beta.ConvertTo(alpha); err != nil {
+		t.Fatalf("ConvertTo: %v", err)
+	}
+	if alpha.Annotations != nil {
+		t.Errorf("annotations = %v, want nil", alpha.Annotations)
+	}
+	if beta.Annotations[v1alpha1StatusAnnotation] == "" {
+		t.Errorf("ConvertTo modified the annotations of the source object")
+	}
+}
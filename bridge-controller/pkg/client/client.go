@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"os"
 
+	kudo "github.com/kudobuilder/kudo/pkg/client/clientset/versioned"
 	log "github.com/sirupsen/logrus"
 	bridge "github.com/zmalik/kudo-bridge/bridge-controller/pkg/generated/clientset/versioned"
 	"k8s.io/client-go/discovery"
@@ -15,6 +16,7 @@ import (
 // Client provides access different K8S clients
 type Client struct {
 	KubeClient kubernetes.Interface
+	KudoClient *kudo.Clientset
 	Discovery  discovery.DiscoveryInterface
 	Bridge     *bridge.Clientset
 }
@@ -48,6 +50,10 @@ func GetKubeClient() (*Client, error) {
 	if err != nil {
 		return nil, fmt.Errorf("could not get Kubernetes client: %s", err)
 	}
+	kudo, err := kudo.NewForConfig(config)
+	if err != nil {
+		return nil, fmt.Errorf("could not get KUDO client: %s", err)
+	}
 	bridge, err := bridge.NewForConfig(config)
 	if err != nil {
 		return nil, fmt.Errorf("could not get Kubernetes client: %s", err)
@@ -56,5 +62,5 @@ func GetKubeClient() (*Client, error) {
 	if err != nil {
 		return nil, fmt.Errorf("could not get Kubernetes client: %s", err)
 	}
-	return &Client{client, discovery, bridge}, nil
+	return &Client{client, kudo, discovery, bridge}, nil
 }
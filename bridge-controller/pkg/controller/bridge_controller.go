@@ -10,27 +10,37 @@ import (
 	"github.com/zmalik/kudo-bridge/bridge-controller/pkg/kudobridge/bridge"
 
 	log "github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	uruntime "k8s.io/apimachinery/pkg/util/runtime"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/apimachinery/pkg/watch"
+	appsinformers "k8s.io/client-go/informers/apps/v1"
+	coreinformers "k8s.io/client-go/informers/core/v1"
+	rbacinformers "k8s.io/client-go/informers/rbac/v1"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/util/workqueue"
 )
 
 type Controller struct {
-	client     *client.Client
-	queue      workqueue.RateLimitingInterface
-	informer   cache.SharedIndexInformer
-	maxRetries int
+	client          *client.Client
+	queue           workqueue.RateLimitingInterface
+	informer        cache.SharedIndexInformer
+	clusterInformer cache.SharedIndexInformer
+	ownedInformers  []cache.SharedIndexInformer
+	maxRetries      int
 
 	bridge *bridge.Bridge
 }
 
-func NewController(client *client.Client) *Controller {
+func NewController(client *client.Client, controllerImage string, controllerTemplate *corev1.PodTemplateSpec, sharedController bool, sharedServiceAccount string) *Controller {
 	bridge := &bridge.Bridge{
-		Client: client,
+		Client:               client,
+		ControllerImage:      controllerImage,
+		ControllerTemplate:   controllerTemplate,
+		SharedController:     sharedController,
+		SharedServiceAccount: sharedServiceAccount,
 	}
 	return &Controller{
 		client:     client,
@@ -57,7 +67,72 @@ func (c *Controller) Run(ctx context.Context) {
 		cache.Indexers{},
 	)
 
-	c.informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+	c.informer.AddEventHandler(c.eventHandler())
+
+	c.clusterInformer = cache.NewSharedIndexInformer(
+		&cache.ListWatch{
+			ListFunc: func(options metav1.ListOptions) (runtime.Object, error) {
+				return c.client.Bridge.KudobridgeV1alpha1().ClusterBridgeInstances().List(context.TODO(), options)
+			},
+			WatchFunc: func(options metav1.ListOptions) (watch.Interface, error) {
+				return c.client.Bridge.KudobridgeV1alpha1().ClusterBridgeInstances().Watch(context.TODO(), options)
+			},
+		},
+		&v1alpha1.ClusterBridgeInstance{},
+		0, //No resync
+		cache.Indexers{},
+	)
+	c.clusterInformer.AddEventHandler(c.eventHandler())
+
+	// the changes of the objects created for a BridgeInstance requeue it, the drift is restored and
+	// the CRD controller Deployment status is reported
+	c.ownedInformers = c.newOwnedInformers()
+	synced := []cache.InformerSynced{c.informer.HasSynced, c.clusterInformer.HasSynced}
+	for _, informer := range c.ownedInformers {
+		informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+			UpdateFunc: func(old, new interface{}) {
+				oldObj, _ := old.(metav1.Object)
+				newObj, _ := new.(metav1.Object)
+				if oldObj.GetResourceVersion() != newObj.GetResourceVersion() {
+					c.enqueueOwner(new)
+				}
+			},
+			DeleteFunc: c.enqueueOwner,
+		})
+		synced = append(synced, informer.HasSynced)
+	}
+
+	go c.informer.Run(stopCh)
+	go c.clusterInformer.Run(stopCh)
+	for _, informer := range c.ownedInformers {
+		go informer.Run(stopCh)
+	}
+
+	log.Infoln("Controller started.")
+	if !cache.WaitForCacheSync(stopCh, synced...) {
+		uruntime.HandleError(fmt.Errorf("timed out waiting for caches to sync"))
+		return
+	}
+	log.Infoln("Controller synced.")
+
+	err := c.bridge.CollectGarbage(func(namespace, name string) bool {
+		if namespace == "" {
+			_, exists, _ := c.clusterInformer.GetStore().GetByKey(name)
+			return exists
+		}
+		_, exists, _ := c.informer.GetStore().GetByKey(fmt.Sprintf("%s/%s", namespace, name))
+		return exists
+	})
+	if err != nil {
+		log.Errorf("Error collecting the resources of the deleted KUDO Bridges: %v", err)
+	}
+
+	wait.Until(c.runWorker, time.Second, stopCh)
+}
+
+// eventHandler queues the BridgeInstances and the ClusterBridgeInstances by key
+func (c *Controller) eventHandler() cache.ResourceEventHandler {
+	return cache.ResourceEventHandlerFuncs{
 		AddFunc: func(obj interface{}) {
 			key, err := cache.DeletionHandlingMetaNamespaceKeyFunc(obj)
 			if err == nil {
@@ -65,8 +140,8 @@ func (c *Controller) Run(ctx context.Context) {
 			}
 		},
 		UpdateFunc: func(old, new interface{}) {
-			oldObj, _ := old.(*v1alpha1.BridgeInstance)
-			newObj, _ := new.(*v1alpha1.BridgeInstance)
+			oldObj, _ := old.(metav1.Object)
+			newObj, _ := new.(metav1.Object)
 			if oldObj.GetResourceVersion() != newObj.GetResourceVersion() {
 				key, err := cache.DeletionHandlingMetaNamespaceKeyFunc(newObj)
 				if err == nil {
@@ -80,18 +155,44 @@ func (c *Controller) Run(ctx context.Context) {
 				c.queue.Add(key)
 			}
 		},
-	})
+	}
+}
 
-	go c.informer.Run(stopCh)
+// newOwnedInformers returns the informers of the objects labeled with their BridgeInstance
+func (c *Controller) newOwnedInformers() []cache.SharedIndexInformer {
+	owned := func(options *metav1.ListOptions) {
+		options.LabelSelector = bridge.NameLabel
+	}
+	kube := c.client.KubeClient
+	return []cache.SharedIndexInformer{
+		appsinformers.NewFilteredDeploymentInformer(kube, metav1.NamespaceAll, 0, cache.Indexers{}, owned),
+		coreinformers.NewFilteredServiceAccountInformer(kube, metav1.NamespaceAll, 0, cache.Indexers{}, owned),
+		rbacinformers.NewFilteredRoleInformer(kube, metav1.NamespaceAll, 0, cache.Indexers{}, owned),
+		rbacinformers.NewFilteredRoleBindingInformer(kube, metav1.NamespaceAll, 0, cache.Indexers{}, owned),
+		rbacinformers.NewFilteredClusterRoleInformer(kube, 0, cache.Indexers{}, owned),
+		rbacinformers.NewFilteredClusterRoleBindingInformer(kube, 0, cache.Indexers{}, owned),
+	}
+}
 
-	log.Infoln("Controller started.")
-	if !cache.WaitForCacheSync(stopCh, c.informer.HasSynced) {
-		uruntime.HandleError(fmt.Errorf("timed out waiting for caches to sync"))
+// enqueueOwner adds the BridgeInstance of the labeled object to the queue
+func (c *Controller) enqueueOwner(obj interface{}) {
+	if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+		obj = tombstone.Obj
+	}
+	o, ok := obj.(metav1.Object)
+	if !ok {
 		return
 	}
-	log.Infoln("Controller synced.")
-
-	wait.Until(c.runWorker, time.Second, stopCh)
+	name, namespace := o.GetLabels()[bridge.NameLabel], o.GetLabels()[bridge.NamespaceLabel]
+	switch {
+	case name == "":
+		return
+	case namespace == "":
+		// owned by a ClusterBridgeInstance
+		c.queue.Add(name)
+	default:
+		c.queue.Add(fmt.Sprintf("%s/%s", namespace, name))
+	}
 }
 
 func (c *Controller) runWorker() {
@@ -122,7 +223,15 @@ func (c *Controller) processNext() bool {
 }
 
 func (c *Controller) processItem(key string) error {
-	obj, _, err := c.informer.GetStore().GetByKey(key)
+	namespace, _, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		return err
+	}
+	store := c.informer.GetStore()
+	if namespace == "" {
+		store = c.clusterInformer.GetStore()
+	}
+	obj, _, err := store.GetByKey(key)
 	if err != nil {
 		return fmt.Errorf("error fetching object with key %s from store: %v", key, err)
 	}
@@ -20,6 +20,7 @@ import (
 	"fmt"
 
 	kudobridgev1alpha1 "github.com/zmalik/kudo-bridge/bridge-controller/pkg/generated/clientset/versioned/typed/kudobridge/v1alpha1"
+	kudobridgev1beta1 "github.com/zmalik/kudo-bridge/bridge-controller/pkg/generated/clientset/versioned/typed/kudobridge/v1beta1"
 	discovery "k8s.io/client-go/discovery"
 	rest "k8s.io/client-go/rest"
 	flowcontrol "k8s.io/client-go/util/flowcontrol"
@@ -28,6 +29,7 @@ import (
 type Interface interface {
 	Discovery() discovery.DiscoveryInterface
 	KudobridgeV1alpha1() kudobridgev1alpha1.KudobridgeV1alpha1Interface
+	KudobridgeV1beta1() kudobridgev1beta1.KudobridgeV1beta1Interface
 }
 
 // Clientset contains the clients for groups. Each group has exactly one
@@ -35,6 +37,7 @@ type Interface interface {
 type Clientset struct {
 	*discovery.DiscoveryClient
 	kudobridgeV1alpha1 *kudobridgev1alpha1.KudobridgeV1alpha1Client
+	kudobridgeV1beta1  *kudobridgev1beta1.KudobridgeV1beta1Client
 }
 
 // KudobridgeV1alpha1 retrieves the KudobridgeV1alpha1Client
@@ -42,6 +45,11 @@ func (c *Clientset) KudobridgeV1alpha1() kudobridgev1alpha1.KudobridgeV1alpha1In
 	return c.kudobridgeV1alpha1
 }
 
+// KudobridgeV1beta1 retrieves the KudobridgeV1beta1Client
+func (c *Clientset) KudobridgeV1beta1() kudobridgev1beta1.KudobridgeV1beta1Interface {
+	return c.kudobridgeV1beta1
+}
+
 // Discovery retrieves the DiscoveryClient
 func (c *Clientset) Discovery() discovery.DiscoveryInterface {
 	if c == nil {
@@ -67,6 +75,10 @@ func NewForConfig(c *rest.Config) (*Clientset, error) {
 	if err != nil {
 		return nil, err
 	}
+	cs.kudobridgeV1beta1, err = kudobridgev1beta1.NewForConfig(&configShallowCopy)
+	if err != nil {
+		return nil, err
+	}
 
 	cs.DiscoveryClient, err = discovery.NewDiscoveryClientForConfig(&configShallowCopy)
 	if err != nil {
@@ -80,6 +92,7 @@ func NewForConfig(c *rest.Config) (*Clientset, error) {
 func NewForConfigOrDie(c *rest.Config) *Clientset {
 	var cs Clientset
 	cs.kudobridgeV1alpha1 = kudobridgev1alpha1.NewForConfigOrDie(c)
+	cs.kudobridgeV1beta1 = kudobridgev1beta1.NewForConfigOrDie(c)
 
 	cs.DiscoveryClient = discovery.NewDiscoveryClientForConfigOrDie(c)
 	return &cs
@@ -89,6 +102,7 @@ func NewForConfigOrDie(c *rest.Config) *Clientset {
 func New(c rest.Interface) *Clientset {
 	var cs Clientset
 	cs.kudobridgeV1alpha1 = kudobridgev1alpha1.New(c)
+	cs.kudobridgeV1beta1 = kudobridgev1beta1.New(c)
 
 	cs.DiscoveryClient = discovery.NewDiscoveryClient(c)
 	return &cs
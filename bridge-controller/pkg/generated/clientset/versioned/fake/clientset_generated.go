@@ -20,6 +20,8 @@ import (
 	clientset "github.com/zmalik/kudo-bridge/bridge-controller/pkg/generated/clientset/versioned"
 	kudobridgev1alpha1 "github.com/zmalik/kudo-bridge/bridge-controller/pkg/generated/clientset/versioned/typed/kudobridge/v1alpha1"
 	fakekudobridgev1alpha1 "github.com/zmalik/kudo-bridge/bridge-controller/pkg/generated/clientset/versioned/typed/kudobridge/v1alpha1/fake"
+	kudobridgev1beta1 "github.com/zmalik/kudo-bridge/bridge-controller/pkg/generated/clientset/versioned/typed/kudobridge/v1beta1"
+	fakekudobridgev1beta1 "github.com/zmalik/kudo-bridge/bridge-controller/pkg/generated/clientset/versioned/typed/kudobridge/v1beta1/fake"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/watch"
 	"k8s.io/client-go/discovery"
@@ -78,3 +80,8 @@ var _ clientset.Interface = &Clientset{}
 func (c *Clientset) KudobridgeV1alpha1() kudobridgev1alpha1.KudobridgeV1alpha1Interface {
 	return &fakekudobridgev1alpha1.FakeKudobridgeV1alpha1{Fake: &c.Fake}
 }
+
+// KudobridgeV1beta1 retrieves the KudobridgeV1beta1Client
+func (c *Clientset) KudobridgeV1beta1() kudobridgev1beta1.KudobridgeV1beta1Interface {
+	return &fakekudobridgev1beta1.FakeKudobridgeV1beta1{Fake: &c.Fake}
+}
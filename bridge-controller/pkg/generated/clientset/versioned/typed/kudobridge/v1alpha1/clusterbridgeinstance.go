@@ -0,0 +1,182 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	"context"
+	"time"
+
+	v1alpha1 "github.com/zmalik/kudo-bridge/bridge-controller/pkg/apis/kudobridge/v1alpha1"
+	scheme "github.com/zmalik/kudo-bridge/bridge-controller/pkg/generated/clientset/versioned/scheme"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	rest "k8s.io/client-go/rest"
+)
+
+// ClusterBridgeInstancesGetter has a method to return a ClusterBridgeInstanceInterface.
+// A group's client should implement this interface.
+type ClusterBridgeInstancesGetter interface {
+	ClusterBridgeInstances() ClusterBridgeInstanceInterface
+}
+
+// ClusterBridgeInstanceInterface has methods to work with ClusterBridgeInstance resources.
+type ClusterBridgeInstanceInterface interface {
+	Create(ctx context.Context, clusterBridgeInstance *v1alpha1.ClusterBridgeInstance, opts v1.CreateOptions) (*v1alpha1.ClusterBridgeInstance, error)
+	Update(ctx context.Context, clusterBridgeInstance *v1alpha1.ClusterBridgeInstance, opts v1.UpdateOptions) (*v1alpha1.ClusterBridgeInstance, error)
+	UpdateStatus(ctx context.Context, clusterBridgeInstance *v1alpha1.ClusterBridgeInstance, opts v1.UpdateOptions) (*v1alpha1.ClusterBridgeInstance, error)
+	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*v1alpha1.ClusterBridgeInstance, error)
+	List(ctx context.Context, opts v1.ListOptions) (*v1alpha1.ClusterBridgeInstanceList, error)
+	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.ClusterBridgeInstance, err error)
+	ClusterBridgeInstanceExpansion
+}
+
+// clusterBridgeInstances implements ClusterBridgeInstanceInterface
+type clusterBridgeInstances struct {
+	client rest.Interface
+}
+
+// newClusterBridgeInstances returns a ClusterBridgeInstances
+func newClusterBridgeInstances(c *KudobridgeV1alpha1Client) *clusterBridgeInstances {
+	return &clusterBridgeInstances{
+		client: c.RESTClient(),
+	}
+}
+
+// Get takes name of the clusterBridgeInstance, and returns the corresponding clusterBridgeInstance object, and an error if there is any.
+func (c *clusterBridgeInstances) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1alpha1.ClusterBridgeInstance, err error) {
+	result = &v1alpha1.ClusterBridgeInstance{}
+	err = c.client.Get().
+		Resource("clusterbridgeinstances").
+		Name(name).
+		VersionedParams(&options, scheme.ParameterCodec).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// List takes label and field selectors, and returns the list of ClusterBridgeInstances that match those selectors.
+func (c *clusterBridgeInstances) List(ctx context.Context, opts v1.ListOptions) (result *v1alpha1.ClusterBridgeInstanceList, err error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	result = &v1alpha1.ClusterBridgeInstanceList{}
+	err = c.client.Get().
+		Resource("clusterbridgeinstances").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Watch returns a watch.Interface that watches the requested clusterBridgeInstances.
+func (c *clusterBridgeInstances) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	opts.Watch = true
+	return c.client.Get().
+		Resource("clusterbridgeinstances").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Watch(ctx)
+}
+
+// Create takes the representation of a clusterBridgeInstance and creates it.  Returns the server's representation of the clusterBridgeInstance, and an error, if there is any.
+func (c *clusterBridgeInstances) Create(ctx context.Context, clusterBridgeInstance *v1alpha1.ClusterBridgeInstance, opts v1.CreateOptions) (result *v1alpha1.ClusterBridgeInstance, err error) {
+	result = &v1alpha1.ClusterBridgeInstance{}
+	err = c.client.Post().
+		Resource("clusterbridgeinstances").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(clusterBridgeInstance).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Update takes the representation of a clusterBridgeInstance and updates it. Returns the server's representation of the clusterBridgeInstance, and an error, if there is any.
+func (c *clusterBridgeInstances) Update(ctx context.Context, clusterBridgeInstance *v1alpha1.ClusterBridgeInstance, opts v1.UpdateOptions) (result *v1alpha1.ClusterBridgeInstance, err error) {
+	result = &v1alpha1.ClusterBridgeInstance{}
+	err = c.client.Put().
+		Resource("clusterbridgeinstances").
+		Name(clusterBridgeInstance.Name).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(clusterBridgeInstance).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *clusterBridgeInstances) UpdateStatus(ctx context.Context, clusterBridgeInstance *v1alpha1.ClusterBridgeInstance, opts v1.UpdateOptions) (result *v1alpha1.ClusterBridgeInstance, err error) {
+	result = &v1alpha1.ClusterBridgeInstance{}
+	err = c.client.Put().
+		Resource("clusterbridgeinstances").
+		Name(clusterBridgeInstance.Name).
+		SubResource("status").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(clusterBridgeInstance).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Delete takes name of the clusterBridgeInstance and deletes it. Returns an error if one occurs.
+func (c *clusterBridgeInstances) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return c.client.Delete().
+		Resource("clusterbridgeinstances").
+		Name(name).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *clusterBridgeInstances) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	var timeout time.Duration
+	if listOpts.TimeoutSeconds != nil {
+		timeout = time.Duration(*listOpts.TimeoutSeconds) * time.Second
+	}
+	return c.client.Delete().
+		Resource("clusterbridgeinstances").
+		VersionedParams(&listOpts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// Patch applies the patch and returns the patched clusterBridgeInstance.
+func (c *clusterBridgeInstances) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.ClusterBridgeInstance, err error) {
+	result = &v1alpha1.ClusterBridgeInstance{}
+	err = c.client.Patch(pt).
+		Resource("clusterbridgeinstances").
+		Name(name).
+		SubResource(subresources...).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
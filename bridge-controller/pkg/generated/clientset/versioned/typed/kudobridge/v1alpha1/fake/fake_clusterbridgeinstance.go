@@ -0,0 +1,131 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	"context"
+
+	v1alpha1 "github.com/zmalik/kudo-bridge/bridge-controller/pkg/apis/kudobridge/v1alpha1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	schema "k8s.io/apimachinery/pkg/runtime/schema"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakeClusterBridgeInstances implements ClusterBridgeInstanceInterface
+type FakeClusterBridgeInstances struct {
+	Fake *FakeKudobridgeV1alpha1
+}
+
+var clusterbridgeinstancesResource = schema.GroupVersionResource{Group: "kudobridge.dev", Version: "v1alpha1", Resource: "clusterbridgeinstances"}
+
+var clusterbridgeinstancesKind = schema.GroupVersionKind{Group: "kudobridge.dev", Version: "v1alpha1", Kind: "ClusterBridgeInstance"}
+
+// Get takes name of the clusterBridgeInstance, and returns the corresponding clusterBridgeInstance object, and an error if there is any.
+func (c *FakeClusterBridgeInstances) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1alpha1.ClusterBridgeInstance, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootGetAction(clusterbridgeinstancesResource, name), &v1alpha1.ClusterBridgeInstance{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.ClusterBridgeInstance), err
+}
+
+// List takes label and field selectors, and returns the list of ClusterBridgeInstances that match those selectors.
+func (c *FakeClusterBridgeInstances) List(ctx context.Context, opts v1.ListOptions) (result *v1alpha1.ClusterBridgeInstanceList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootListAction(clusterbridgeinstancesResource, clusterbridgeinstancesKind, opts), &v1alpha1.ClusterBridgeInstanceList{})
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v1alpha1.ClusterBridgeInstanceList{ListMeta: obj.(*v1alpha1.ClusterBridgeInstanceList).ListMeta}
+	for _, item := range obj.(*v1alpha1.ClusterBridgeInstanceList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested clusterBridgeInstances.
+func (c *FakeClusterBridgeInstances) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewRootWatchAction(clusterbridgeinstancesResource, opts))
+}
+
+// Create takes the representation of a clusterBridgeInstance and creates it.  Returns the server's representation of the clusterBridgeInstance, and an error, if there is any.
+func (c *FakeClusterBridgeInstances) Create(ctx context.Context, clusterBridgeInstance *v1alpha1.ClusterBridgeInstance, opts v1.CreateOptions) (result *v1alpha1.ClusterBridgeInstance, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootCreateAction(clusterbridgeinstancesResource, clusterBridgeInstance), &v1alpha1.ClusterBridgeInstance{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.ClusterBridgeInstance), err
+}
+
+// Update takes the representation of a clusterBridgeInstance and updates it. Returns the server's representation of the clusterBridgeInstance, and an error, if there is any.
+func (c *FakeClusterBridgeInstances) Update(ctx context.Context, clusterBridgeInstance *v1alpha1.ClusterBridgeInstance, opts v1.UpdateOptions) (result *v1alpha1.ClusterBridgeInstance, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootUpdateAction(clusterbridgeinstancesResource, clusterBridgeInstance), &v1alpha1.ClusterBridgeInstance{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.ClusterBridgeInstance), err
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *FakeClusterBridgeInstances) UpdateStatus(ctx context.Context, clusterBridgeInstance *v1alpha1.ClusterBridgeInstance, opts v1.UpdateOptions) (*v1alpha1.ClusterBridgeInstance, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootUpdateSubresourceAction(clusterbridgeinstancesResource, "status", clusterBridgeInstance), &v1alpha1.ClusterBridgeInstance{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.ClusterBridgeInstance), err
+}
+
+// Delete takes name of the clusterBridgeInstance and deletes it. Returns an error if one occurs.
+func (c *FakeClusterBridgeInstances) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewRootDeleteAction(clusterbridgeinstancesResource, name), &v1alpha1.ClusterBridgeInstance{})
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeClusterBridgeInstances) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	action := testing.NewRootDeleteCollectionAction(clusterbridgeinstancesResource, listOpts)
+
+	_, err := c.Fake.Invokes(action, &v1alpha1.ClusterBridgeInstanceList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched clusterBridgeInstance.
+func (c *FakeClusterBridgeInstances) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.ClusterBridgeInstance, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootPatchSubresourceAction(clusterbridgeinstancesResource, name, pt, data, subresources...), &v1alpha1.ClusterBridgeInstance{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.ClusterBridgeInstance), err
+}
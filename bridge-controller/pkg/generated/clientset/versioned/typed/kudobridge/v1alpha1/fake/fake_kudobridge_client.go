@@ -30,6 +30,10 @@ func (c *FakeKudobridgeV1alpha1) BridgeInstances(namespace string) v1alpha1.Brid
 	return &FakeBridgeInstances{c, namespace}
 }
 
+func (c *FakeKudobridgeV1alpha1) ClusterBridgeInstances() v1alpha1.ClusterBridgeInstanceInterface {
+	return &FakeClusterBridgeInstances{c}
+}
+
 // RESTClient returns a RESTClient that is used to communicate
 // with API server by this client implementation.
 func (c *FakeKudobridgeV1alpha1) RESTClient() rest.Interface {
@@ -17,3 +17,5 @@ limitations under the License.
 package v1alpha1
 
 type BridgeInstanceExpansion interface{}
+
+type ClusterBridgeInstanceExpansion interface{}
@@ -25,6 +25,7 @@ import (
 type KudobridgeV1alpha1Interface interface {
 	RESTClient() rest.Interface
 	BridgeInstancesGetter
+	ClusterBridgeInstancesGetter
 }
 
 // KudobridgeV1alpha1Client is used to interact with features provided by the kudobridge.dev group.
@@ -36,6 +37,10 @@ func (c *KudobridgeV1alpha1Client) BridgeInstances(namespace string) BridgeInsta
 	return newBridgeInstances(c, namespace)
 }
 
+func (c *KudobridgeV1alpha1Client) ClusterBridgeInstances() ClusterBridgeInstanceInterface {
+	return newClusterBridgeInstances(c)
+}
+
 // NewForConfig creates a new KudobridgeV1alpha1Client for the given config.
 func NewForConfig(c *rest.Config) (*KudobridgeV1alpha1Client, error) {
 	config := *c
@@ -0,0 +1,193 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	"context"
+	"time"
+
+	v1beta1 "github.com/zmalik/kudo-bridge/bridge-controller/pkg/apis/kudobridge/v1beta1"
+	scheme "github.com/zmalik/kudo-bridge/bridge-controller/pkg/generated/clientset/versioned/scheme"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	rest "k8s.io/client-go/rest"
+)
+
+// BridgeInstancesGetter has a method to return a BridgeInstanceInterface.
+// A group's client should implement this interface.
+type BridgeInstancesGetter interface {
+	BridgeInstances(namespace string) BridgeInstanceInterface
+}
+
+// BridgeInstanceInterface has methods to work with BridgeInstance resources.
+type BridgeInstanceInterface interface {
+	Create(ctx context.Context, bridgeInstance *v1beta1.BridgeInstance, opts v1.CreateOptions) (*v1beta1.BridgeInstance, error)
+	Update(ctx context.Context, bridgeInstance *v1beta1.BridgeInstance, opts v1.UpdateOptions) (*v1beta1.BridgeInstance, error)
+	UpdateStatus(ctx context.Context, bridgeInstance *v1beta1.BridgeInstance, opts v1.UpdateOptions) (*v1beta1.BridgeInstance, error)
+	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*v1beta1.BridgeInstance, error)
+	List(ctx context.Context, opts v1.ListOptions) (*v1beta1.BridgeInstanceList, error)
+	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1beta1.BridgeInstance, err error)
+	BridgeInstanceExpansion
+}
+
+// bridgeInstances implements BridgeInstanceInterface
+type bridgeInstances struct {
+	client rest.Interface
+	ns     string
+}
+
+// newBridgeInstances returns a BridgeInstances
+func newBridgeInstances(c *KudobridgeV1beta1Client, namespace string) *bridgeInstances {
+	return &bridgeInstances{
+		client: c.RESTClient(),
+		ns:     namespace,
+	}
+}
+
+// Get takes name of the bridgeInstance, and returns the corresponding bridgeInstance object, and an error if there is any.
+func (c *bridgeInstances) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1beta1.BridgeInstance, err error) {
+	result = &v1beta1.BridgeInstance{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("bridgeinstances").
+		Name(name).
+		VersionedParams(&options, scheme.ParameterCodec).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// List takes label and field selectors, and returns the list of BridgeInstances that match those selectors.
+func (c *bridgeInstances) List(ctx context.Context, opts v1.ListOptions) (result *v1beta1.BridgeInstanceList, err error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	result = &v1beta1.BridgeInstanceList{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("bridgeinstances").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Watch returns a watch.Interface that watches the requested bridgeInstances.
+func (c *bridgeInstances) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	opts.Watch = true
+	return c.client.Get().
+		Namespace(c.ns).
+		Resource("bridgeinstances").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Watch(ctx)
+}
+
+// Create takes the representation of a bridgeInstance and creates it.  Returns the server's representation of the bridgeInstance, and an error, if there is any.
+func (c *bridgeInstances) Create(ctx context.Context, bridgeInstance *v1beta1.BridgeInstance, opts v1.CreateOptions) (result *v1beta1.BridgeInstance, err error) {
+	result = &v1beta1.BridgeInstance{}
+	err = c.client.Post().
+		Namespace(c.ns).
+		Resource("bridgeinstances").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(bridgeInstance).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Update takes the representation of a bridgeInstance and updates it. Returns the server's representation of the bridgeInstance, and an error, if there is any.
+func (c *bridgeInstances) Update(ctx context.Context, bridgeInstance *v1beta1.BridgeInstance, opts v1.UpdateOptions) (result *v1beta1.BridgeInstance, err error) {
+	result = &v1beta1.BridgeInstance{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("bridgeinstances").
+		Name(bridgeInstance.Name).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(bridgeInstance).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *bridgeInstances) UpdateStatus(ctx context.Context, bridgeInstance *v1beta1.BridgeInstance, opts v1.UpdateOptions) (result *v1beta1.BridgeInstance, err error) {
+	result = &v1beta1.BridgeInstance{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("bridgeinstances").
+		Name(bridgeInstance.Name).
+		SubResource("status").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(bridgeInstance).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Delete takes name of the bridgeInstance and deletes it. Returns an error if one occurs.
+func (c *bridgeInstances) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("bridgeinstances").
+		Name(name).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *bridgeInstances) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	var timeout time.Duration
+	if listOpts.TimeoutSeconds != nil {
+		timeout = time.Duration(*listOpts.TimeoutSeconds) * time.Second
+	}
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("bridgeinstances").
+		VersionedParams(&listOpts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// Patch applies the patch and returns the patched bridgeInstance.
+func (c *bridgeInstances) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1beta1.BridgeInstance, err error) {
+	result = &v1beta1.BridgeInstance{}
+	err = c.client.Patch(pt).
+		Namespace(c.ns).
+		Resource("bridgeinstances").
+		Name(name).
+		SubResource(subresources...).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
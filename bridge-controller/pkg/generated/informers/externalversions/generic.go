@@ -20,6 +20,7 @@ import (
 	"fmt"
 
 	v1alpha1 "github.com/zmalik/kudo-bridge/bridge-controller/pkg/apis/kudobridge/v1alpha1"
+	v1beta1 "github.com/zmalik/kudo-bridge/bridge-controller/pkg/apis/kudobridge/v1beta1"
 	schema "k8s.io/apimachinery/pkg/runtime/schema"
 	cache "k8s.io/client-go/tools/cache"
 )
@@ -53,6 +54,12 @@ func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource
 	// Group=kudobridge.dev, Version=v1alpha1
 	case v1alpha1.SchemeGroupVersion.WithResource("bridgeinstances"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Kudobridge().V1alpha1().BridgeInstances().Informer()}, nil
+	case v1alpha1.SchemeGroupVersion.WithResource("clusterbridgeinstances"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Kudobridge().V1alpha1().ClusterBridgeInstances().Informer()}, nil
+
+		// Group=kudobridge.dev, Version=v1beta1
+	case v1beta1.SchemeGroupVersion.WithResource("bridgeinstances"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Kudobridge().V1beta1().BridgeInstances().Informer()}, nil
 
 	}
 
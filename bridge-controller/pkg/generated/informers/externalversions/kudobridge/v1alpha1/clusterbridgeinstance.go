@@ -0,0 +1,87 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by informer-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	"context"
+	time "time"
+
+	kudobridgev1alpha1 "github.com/zmalik/kudo-bridge/bridge-controller/pkg/apis/kudobridge/v1alpha1"
+	versioned "github.com/zmalik/kudo-bridge/bridge-controller/pkg/generated/clientset/versioned"
+	internalinterfaces "github.com/zmalik/kudo-bridge/bridge-controller/pkg/generated/informers/externalversions/internalinterfaces"
+	v1alpha1 "github.com/zmalik/kudo-bridge/bridge-controller/pkg/generated/listers/kudobridge/v1alpha1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+	watch "k8s.io/apimachinery/pkg/watch"
+	cache "k8s.io/client-go/tools/cache"
+)
+
+// ClusterBridgeInstanceInformer provides access to a shared informer and lister for
+// ClusterBridgeInstances.
+type ClusterBridgeInstanceInformer interface {
+	Informer() cache.SharedIndexInformer
+	Lister() v1alpha1.ClusterBridgeInstanceLister
+}
+
+type clusterBridgeInstanceInformer struct {
+	factory          internalinterfaces.SharedInformerFactory
+	tweakListOptions internalinterfaces.TweakListOptionsFunc
+}
+
+// NewClusterBridgeInstanceInformer constructs a new informer for ClusterBridgeInstance type.
+// Always prefer using an informer factory to get a shared informer instead of getting an independent
+// one. This reduces memory footprint and number of connections to the server.
+func NewClusterBridgeInstanceInformer(client versioned.Interface, resyncPeriod time.Duration, indexers cache.Indexers) cache.SharedIndexInformer {
+	return NewFilteredClusterBridgeInstanceInformer(client, resyncPeriod, indexers, nil)
+}
+
+// NewFilteredClusterBridgeInstanceInformer constructs a new informer for ClusterBridgeInstance type.
+// Always prefer using an informer factory to get a shared informer instead of getting an independent
+// one. This reduces memory footprint and number of connections to the server.
+func NewFilteredClusterBridgeInstanceInformer(client versioned.Interface, resyncPeriod time.Duration, indexers cache.Indexers, tweakListOptions internalinterfaces.TweakListOptionsFunc) cache.SharedIndexInformer {
+	return cache.NewSharedIndexInformer(
+		&cache.ListWatch{
+			ListFunc: func(options v1.ListOptions) (runtime.Object, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.KudobridgeV1alpha1().ClusterBridgeInstances().List(context.TODO(), options)
+			},
+			WatchFunc: func(options v1.ListOptions) (watch.Interface, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.KudobridgeV1alpha1().ClusterBridgeInstances().Watch(context.TODO(), options)
+			},
+		},
+		&kudobridgev1alpha1.ClusterBridgeInstance{},
+		resyncPeriod,
+		indexers,
+	)
+}
+
+func (f *clusterBridgeInstanceInformer) defaultInformer(client versioned.Interface, resyncPeriod time.Duration) cache.SharedIndexInformer {
+	return NewFilteredClusterBridgeInstanceInformer(client, resyncPeriod, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc}, f.tweakListOptions)
+}
+
+func (f *clusterBridgeInstanceInformer) Informer() cache.SharedIndexInformer {
+	return f.factory.InformerFor(&kudobridgev1alpha1.ClusterBridgeInstance{}, f.defaultInformer)
+}
+
+func (f *clusterBridgeInstanceInformer) Lister() v1alpha1.ClusterBridgeInstanceLister {
+	return v1alpha1.NewClusterBridgeInstanceLister(f.Informer().GetIndexer())
+}
@@ -24,6 +24,8 @@ import (
 type Interface interface {
 	// BridgeInstances returns a BridgeInstanceInformer.
 	BridgeInstances() BridgeInstanceInformer
+	// ClusterBridgeInstances returns a ClusterBridgeInstanceInformer.
+	ClusterBridgeInstances() ClusterBridgeInstanceInformer
 }
 
 type version struct {
@@ -41,3 +43,8 @@ func New(f internalinterfaces.SharedInformerFactory, namespace string, tweakList
 func (v *version) BridgeInstances() BridgeInstanceInformer {
 	return &bridgeInstanceInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
+
+// ClusterBridgeInstances returns a ClusterBridgeInstanceInformer.
+func (v *version) ClusterBridgeInstances() ClusterBridgeInstanceInformer {
+	return &clusterBridgeInstanceInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
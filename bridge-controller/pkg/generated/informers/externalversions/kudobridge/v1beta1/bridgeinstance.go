@@ -0,0 +1,88 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by informer-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	"context"
+	time "time"
+
+	kudobridgev1beta1 "github.com/zmalik/kudo-bridge/bridge-controller/pkg/apis/kudobridge/v1beta1"
+	versioned "github.com/zmalik/kudo-bridge/bridge-controller/pkg/generated/clientset/versioned"
+	internalinterfaces "github.com/zmalik/kudo-bridge/bridge-controller/pkg/generated/informers/externalversions/internalinterfaces"
+	v1beta1 "github.com/zmalik/kudo-bridge/bridge-controller/pkg/generated/listers/kudobridge/v1beta1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+	watch "k8s.io/apimachinery/pkg/watch"
+	cache "k8s.io/client-go/tools/cache"
+)
+
+// BridgeInstanceInformer provides access to a shared informer and lister for
+// BridgeInstances.
+type BridgeInstanceInformer interface {
+	Informer() cache.SharedIndexInformer
+	Lister() v1beta1.BridgeInstanceLister
+}
+
+type bridgeInstanceInformer struct {
+	factory          internalinterfaces.SharedInformerFactory
+	tweakListOptions internalinterfaces.TweakListOptionsFunc
+	namespace        string
+}
+
+// NewBridgeInstanceInformer constructs a new informer for BridgeInstance type.
+// Always prefer using an informer factory to get a shared informer instead of getting an independent
+// one. This reduces memory footprint and number of connections to the server.
+func NewBridgeInstanceInformer(client versioned.Interface, namespace string, resyncPeriod time.Duration, indexers cache.Indexers) cache.SharedIndexInformer {
+	return NewFilteredBridgeInstanceInformer(client, namespace, resyncPeriod, indexers, nil)
+}
+
+// NewFilteredBridgeInstanceInformer constructs a new informer for BridgeInstance type.
+// Always prefer using an informer factory to get a shared informer instead of getting an independent
+// one. This reduces memory footprint and number of connections to the server.
+func NewFilteredBridgeInstanceInformer(client versioned.Interface, namespace string, resyncPeriod time.Duration, indexers cache.Indexers, tweakListOptions internalinterfaces.TweakListOptionsFunc) cache.SharedIndexInformer {
+	return cache.NewSharedIndexInformer(
+		&cache.ListWatch{
+			ListFunc: func(options v1.ListOptions) (runtime.Object, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.KudobridgeV1beta1().BridgeInstances(namespace).List(context.TODO(), options)
+			},
+			WatchFunc: func(options v1.ListOptions) (watch.Interface, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.KudobridgeV1beta1().BridgeInstances(namespace).Watch(context.TODO(), options)
+			},
+		},
+		&kudobridgev1beta1.BridgeInstance{},
+		resyncPeriod,
+		indexers,
+	)
+}
+
+func (f *bridgeInstanceInformer) defaultInformer(client versioned.Interface, resyncPeriod time.Duration) cache.SharedIndexInformer {
+	return NewFilteredBridgeInstanceInformer(client, f.namespace, resyncPeriod, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc}, f.tweakListOptions)
+}
+
+func (f *bridgeInstanceInformer) Informer() cache.SharedIndexInformer {
+	return f.factory.InformerFor(&kudobridgev1beta1.BridgeInstance{}, f.defaultInformer)
+}
+
+func (f *bridgeInstanceInformer) Lister() v1beta1.BridgeInstanceLister {
+	return v1beta1.NewBridgeInstanceLister(f.Informer().GetIndexer())
+}
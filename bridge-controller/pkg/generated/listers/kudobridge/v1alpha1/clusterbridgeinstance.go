@@ -0,0 +1,63 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1alpha1 "github.com/zmalik/kudo-bridge/bridge-controller/pkg/apis/kudobridge/v1alpha1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+)
+
+// ClusterBridgeInstanceLister helps list ClusterBridgeInstances.
+type ClusterBridgeInstanceLister interface {
+	// List lists all ClusterBridgeInstances in the indexer.
+	List(selector labels.Selector) (ret []*v1alpha1.ClusterBridgeInstance, err error)
+	// Get retrieves the ClusterBridgeInstance from the index for a given name.
+	Get(name string) (*v1alpha1.ClusterBridgeInstance, error)
+	ClusterBridgeInstanceListerExpansion
+}
+
+// clusterBridgeInstanceLister implements the ClusterBridgeInstanceLister interface.
+type clusterBridgeInstanceLister struct {
+	indexer cache.Indexer
+}
+
+// NewClusterBridgeInstanceLister returns a new ClusterBridgeInstanceLister.
+func NewClusterBridgeInstanceLister(indexer cache.Indexer) ClusterBridgeInstanceLister {
+	return &clusterBridgeInstanceLister{indexer: indexer}
+}
+
+// List lists all ClusterBridgeInstances in the indexer.
+func (s *clusterBridgeInstanceLister) List(selector labels.Selector) (ret []*v1alpha1.ClusterBridgeInstance, err error) {
+	err = cache.ListAll(s.indexer, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1alpha1.ClusterBridgeInstance))
+	})
+	return ret, err
+}
+
+// Get retrieves the ClusterBridgeInstance from the index for a given name.
+func (s *clusterBridgeInstanceLister) Get(name string) (*v1alpha1.ClusterBridgeInstance, error) {
+	obj, exists, err := s.indexer.GetByKey(name)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(v1alpha1.Resource("clusterbridgeinstance"), name)
+	}
+	return obj.(*v1alpha1.ClusterBridgeInstance), nil
+}
@@ -23,3 +23,7 @@ type BridgeInstanceListerExpansion interface{}
 // BridgeInstanceNamespaceListerExpansion allows custom methods to be added to
 // BridgeInstanceNamespaceLister.
 type BridgeInstanceNamespaceListerExpansion interface{}
+
+// ClusterBridgeInstanceListerExpansion allows custom methods to be added to
+// ClusterBridgeInstanceLister.
+type ClusterBridgeInstanceListerExpansion interface{}
@@ -5,12 +5,13 @@ import (
 	"fmt"
 
 	log "github.com/sirupsen/logrus"
-	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/api/rbac/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/client-go/discovery/cached/memory"
 	"k8s.io/client-go/restmapper"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
@@ -26,18 +27,29 @@ const (
 
 type Bridge struct {
 	*client.Client
+
+	// ControllerImage is the default image of the crd-controller container
+	ControllerImage string
+	// ControllerTemplate holds the cluster wide defaults of the crd-controller pod template
+	ControllerTemplate *corev1.PodTemplateSpec
+	// SharedController is set when a single crd-controller processes all the BridgeInstances, no crd-controller
+	// is deployed per BridgeInstance
+	SharedController bool
+	// SharedServiceAccount is the "namespace/name" ServiceAccount of the shared crd-controller, the RBAC of the
+	// BridgeInstances is bound to it
+	SharedServiceAccount string
 }
 
 func (b *Bridge) Process(ro runtime.Object) error {
-	ctx := context.TODO()
 	if ro == nil {
 		// Event was deleted
 		return nil
 	}
 
-	bi, ok := ro.(*v1alpha1.BridgeInstance)
-	if !ok {
+	bi, err := bridgeInstance(ro)
+	if err != nil {
 		log.Infoln("cannot cast the object to ExternalService", ro)
+		return err
 	}
 
 	// marked for deletion
@@ -48,312 +60,242 @@ func (b *Bridge) Process(ro runtime.Object) error {
 		return nil
 	}
 
-	err := b.validateCRD(bi)
-	if err != nil {
-		return err
+	if !containsFinalizer(bi, finalizerName) {
+		// the update requeues the BridgeInstance
+		return b.AddFinalizer(bi)
 	}
 
-	err = setGVKFromScheme(bi)
+	status := bi.Status.DeepCopy()
+	err = b.reconcile(bi, status)
+	status.ObservedGeneration = bi.GetGeneration()
+	if statusErr := b.updateStatus(bi, status); statusErr != nil {
+		log.Errorf("Error updating the status of the KUDO Bridge %s/%s: %v", bi.Namespace, bi.Name, statusErr)
+		if err == nil {
+			err = statusErr
+		}
+	}
+	return err
+}
+
+// reconcile brings the CRD controller of the BridgeInstance to its desired state and records the conditions in the status
+func (b *Bridge) reconcile(bi *v1alpha1.BridgeInstance, status *v1alpha1.BridgeInstanceStatus) error {
+	if bi.Spec.Suspend {
+		// the CRD controller keeps running, it reports the pending changes in the CRD objects status
+		setCondition(bi, status, v1alpha1.ConditionSuspended, metav1.ConditionTrue, "Suspended", "the KUDO Instances are not installed or updated")
+	} else {
+		setCondition(bi, status, v1alpha1.ConditionSuspended, metav1.ConditionFalse, "Active", "")
+	}
+
+	mapping, err := b.restMapping(bi)
 	if err != nil {
-		return fmt.Errorf("could not set GroupVerionKind for %s/%s: %v", bi.GetNamespace(), bi.GetName(), err)
+		// the CRD controller is deployed anyway, it waits for the CRD and reports the CRDWatched condition
+		log.Infof("The CRD of %s/%s is not served yet: %v", bi.Namespace, bi.Name, err)
+		setCondition(bi, status, v1alpha1.ConditionCRDResolved, metav1.ConditionFalse, "CRDNotFound", err.Error())
+	} else if err := ValidateScope(bi, mapping.Scope); err != nil {
+		setCondition(bi, status, v1alpha1.ConditionCRDResolved, metav1.ConditionFalse, "ScopeMismatch", err.Error())
+		return err
+	} else {
+		setCondition(bi, status, v1alpha1.ConditionCRDResolved, metav1.ConditionTrue, "CRDFound", "")
 	}
 
-	_, err = b.KubeClient.AppsV1().Deployments(bi.GetNamespace()).Get(ctx, bi.GetName(), metav1.GetOptions{})
-	if errors.IsNotFound(err) {
-		// create sa/role
-		sa, err := b.createSARole(bi)
-		if err != nil {
-			log.Errorf("Error creating service account the KUDO Bridge %s/%s: %v", bi.Namespace, bi.Name, err)
-			return err
-		}
-		// create deployment
-		newDeployment := &appsv1.Deployment{
-			ObjectMeta: metav1.ObjectMeta{
-				Name:      bi.GetName(),
-				Namespace: bi.GetNamespace(),
-				OwnerReferences: []metav1.OwnerReference{
-					{
-						APIVersion: bi.APIVersion,
-						Kind:       bi.Kind,
-						Name:       bi.GetName(),
-						UID:        bi.GetUID(),
-					},
-				},
-			},
-			Spec: appsv1.DeploymentSpec{
-				Selector: &metav1.LabelSelector{
-					MatchLabels: map[string]string{"kudobridge.dev": bi.GetName()},
-				},
-				Template: corev1.PodTemplateSpec{
-					ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"kudobridge.dev": bi.GetName()}},
-					Spec: corev1.PodSpec{
-						ServiceAccountName: sa.GetName(),
-						Containers: []corev1.Container{
-							{
-								Name:            "crd-controller",
-								Image:           "zmalikshxil/kudo-crd-controller:0.0.1-alpha",
-								ImagePullPolicy: corev1.PullAlways,
-								Env: []corev1.EnvVar{
-									{
-										Name:  "GROUP_VERSION",
-										Value: bi.Spec.CRDSpec.GetAPIVersion(),
-									},
-									{
-										Name:  "KIND",
-										Value: bi.Spec.CRDSpec.GetKind(),
-									},
-								},
-								Command: []string{"/root/crd-controller"},
-								Args: []string{
-									fmt.Sprintf("-group-version=%s", bi.Spec.CRDSpec.GetAPIVersion()),
-									fmt.Sprintf("-kind=%s", bi.Spec.CRDSpec.GetKind()),
-								},
-							},
-						},
-					},
-				},
-			},
-		}
-		dep, err := b.KubeClient.AppsV1().Deployments(bi.GetNamespace()).Create(context.TODO(), newDeployment, metav1.CreateOptions{})
+	b.resolveOperator(bi, status)
+
+	if !bi.IsCluster() {
+		// the kind of a ClusterBridgeInstance is kept for the owner references
+		err = setGVKFromScheme(bi)
 		if err != nil {
-			log.Errorf("Error creating deployment for the KUDO Bridge %s/%s: %v", bi.Namespace, bi.Name, err)
-			return err
+			return fmt.Errorf("could not set GroupVerionKind for %s/%s: %v", bi.GetNamespace(), bi.GetName(), err)
 		}
-		log.Infof("CRD Controller deployment %s/%s created for ExternalService %s/%s", dep.GetNamespace(), dep.GetName(), bi.Namespace, bi.Name)
+	}
 
-	} else if err != nil {
-		log.Errorf("Error fetching deployment for the KUDO Bridge %s/%s: %v", bi.Namespace, bi.Name, err)
+	if err := b.reconcileRBAC(bi); err != nil {
+		log.Errorf("Error reconciling the RBAC of the KUDO Bridge %s/%s: %v", bi.Namespace, bi.Name, err)
+		setCondition(bi, status, v1alpha1.ConditionRBACReady, metav1.ConditionFalse, "ReconcileFailed", err.Error())
 		return err
-	} else {
-		log.Infof("deployment %s/%s already exists, updates aren't supported yet", bi.Namespace, bi.Name)
 	}
+	setCondition(bi, status, v1alpha1.ConditionRBACReady, metav1.ConditionTrue, "Reconciled", "")
+
+	if b.SharedController {
+		return b.reconcileShared(bi, status)
+	}
+
+	dep, err := b.reconcileDeployment(bi)
+	if err != nil {
+		log.Errorf("Error reconciling the deployment of the KUDO Bridge %s/%s: %v", bi.Namespace, bi.Name, err)
+		setCondition(bi, status, v1alpha1.ConditionControllerDeployed, metav1.ConditionFalse, "ReconcileFailed", err.Error())
+		return err
+	}
+	setCondition(bi, status, v1alpha1.ConditionControllerDeployed, metav1.ConditionTrue, "Reconciled", "")
+	setAvailableCondition(bi, status, dep)
 	return nil
 }
 
-func (b *Bridge) createSARole(bi *v1alpha1.BridgeInstance) (*corev1.ServiceAccount, error) {
-	sa, err := b.KubeClient.CoreV1().ServiceAccounts(bi.GetNamespace()).Get(context.TODO(), bi.GetName(), metav1.GetOptions{})
-	if errors.IsNotFound(err) {
-		sa = &corev1.ServiceAccount{
-			ObjectMeta: metav1.ObjectMeta{
-				Name:      bi.GetName(),
-				Namespace: bi.GetNamespace(),
-				OwnerReferences: []metav1.OwnerReference{
-					{
-						APIVersion: bi.APIVersion,
-						Kind:       bi.Kind,
-						Name:       bi.GetName(),
-						UID:        bi.GetUID(),
-					},
-				},
-			},
-		}
-		sa, err = b.KubeClient.CoreV1().ServiceAccounts(bi.GetNamespace()).Create(context.TODO(), sa, metav1.CreateOptions{})
-		if err != nil {
-			log.Errorf("Error creating service account the KUDO Bridge %s/%s: %v", bi.Namespace, bi.Name, err)
-			return nil, err
-		}
-		log.Infof("ServiceAccount %s/%s created for ExternalService %s/%s", sa.GetNamespace(), sa.GetName(), bi.Namespace, bi.Name)
+// restMapping returns the mapping of the watched CRD, an error when the API server doesn't serve it
+func (b *Bridge) restMapping(bi *v1alpha1.BridgeInstance) (*meta.RESTMapping, error) {
+	gvk := bi.Spec.CRDSpec.GroupVersionKind()
+	return restmapper.NewDeferredDiscoveryRESTMapper(memory.NewMemCacheClient(b.Discovery)).RESTMapping(gvk.GroupKind(), gvk.Version)
+}
+
+// ValidateScope checks that the cluster scoped CRDs are bridged by a ClusterBridgeInstance and the namespaced ones by a BridgeInstance
+func ValidateScope(bi *v1alpha1.BridgeInstance, scope meta.RESTScope) error {
+	clusterScoped := scope.Name() == meta.RESTScopeNameRoot
+	switch {
+	case clusterScoped && !bi.IsCluster():
+		return fmt.Errorf("%s is cluster scoped, it must be bridged by a %s", bi.Spec.CRDSpec.GroupVersionKind(), v1alpha1.ClusterBridgeInstanceKind)
+	case !clusterScoped && bi.IsCluster():
+		return fmt.Errorf("%s is namespaced, it must be bridged by a BridgeInstance", bi.Spec.CRDSpec.GroupVersionKind())
+	case bi.IsCluster() && bi.GetNamespace() == "":
+		return fmt.Errorf("the targetNamespace of %s %s must be set", v1alpha1.ClusterBridgeInstanceKind, bi.GetName())
 	}
-	return sa, b.createRBAC(bi)
+	return nil
 }
 
-func (b *Bridge) createRBAC(bi *v1alpha1.BridgeInstance) error {
-	_, err := b.KubeClient.RbacV1().Roles(bi.GetNamespace()).Get(context.TODO(), bi.GetName(), metav1.GetOptions{})
-	if errors.IsNotFound(err) {
-		role := &v1.Role{
-			ObjectMeta: metav1.ObjectMeta{
-				Name:      bi.GetName(),
-				Namespace: bi.GetNamespace(),
-				OwnerReferences: []metav1.OwnerReference{
-					{
-						APIVersion: bi.APIVersion,
-						Kind:       bi.Kind,
-						Name:       bi.GetName(),
-						UID:        bi.GetUID(),
-					},
-				},
-			},
-			Rules: []v1.PolicyRule{
-				{
-					Verbs:         []string{"get", "watch", "list"},
-					Resources:     []string{"bridgeinstances"},
-					APIGroups:     []string{"kudobridge.dev"},
-					ResourceNames: []string{},
-				},
-				{
-					Verbs:         []string{"get", "watch", "list", "create", "update", "patch", "delete"},
-					Resources:     []string{"operatorversions", "instances", "operators"},
-					APIGroups:     []string{"kudo.dev"},
-					ResourceNames: []string{},
-				},
-			},
-		}
-		role, err = b.KubeClient.RbacV1().Roles(bi.GetNamespace()).Create(context.TODO(), role, metav1.CreateOptions{})
-		if err != nil {
-			log.Errorf("Error creating Role for the KUDO Bridge %s/%s: %v", bi.Namespace, bi.Name, err)
-			return err
-		}
-		log.Infof("Role %s/%s created for ExternalService %s/%s", role.GetNamespace(), role.GetName(), bi.Namespace, bi.Name)
-	} else if err != nil {
+// AddFinalizer adds the finalizer cleaning the cluster scope resources up on deletion
+func (b *Bridge) AddFinalizer(bi *v1alpha1.BridgeInstance) error {
+	updated := bi.DeepCopy()
+	controllerutil.AddFinalizer(updated, finalizerName)
+	err := b.update(updated)
+	if err != nil {
+		log.Errorf("Cannot add finalizer %s to %s/%s: %v", finalizerName, bi.GetNamespace(), bi.GetName(), err)
 		return err
 	}
+	log.Infof("Added finalizer %s to %s/%s", finalizerName, bi.GetNamespace(), bi.GetName())
+	return nil
+}
 
-	clusterRoleName := fmt.Sprintf("kudobridge-%s-%s", bi.GetNamespace(), bi.GetName())
-	_, err = b.KubeClient.RbacV1().ClusterRoles().Get(context.TODO(), clusterRoleName, metav1.GetOptions{})
-	if errors.IsNotFound(err) {
-		clusterRole := &v1.ClusterRole{
-			ObjectMeta: metav1.ObjectMeta{
-				Name: clusterRoleName,
-				Labels: map[string]string{
-					"kudobridge.dev/CRDKind":   bi.Spec.CRDSpec.GetKind(),
-					"kudobridge.dev/name":      bi.GetName(),
-					"kudobridge.dev/namespace": bi.GetNamespace(),
-				},
-			},
-			Rules: []v1.PolicyRule{
-				{
-					Verbs:         []string{"get", "watch", "list"},
-					Resources:     []string{"*"},
-					APIGroups:     []string{bi.Spec.CRDSpec.GroupVersionKind().GroupVersion().Group},
-					ResourceNames: []string{},
-				},
-				{
-					Verbs:         []string{"get", "watch", "list"},
-					Resources:     []string{"customresourcedefinitions"},
-					APIGroups:     []string{"apiextensions.k8s.io"},
-					ResourceNames: []string{},
-				},
-			},
-		}
-		clusterRole, err = b.KubeClient.RbacV1().ClusterRoles().Create(context.TODO(), clusterRole, metav1.CreateOptions{})
-		if err != nil {
-			log.Errorf("Error creating ClusterRole for the KUDO Bridge %s/%s: %v", bi.Namespace, bi.Name, err)
-			return err
-		}
-		log.Infof("ClusterRole %s created for ExternalService %s/%s", clusterRole.GetName(), bi.Namespace, bi.Name)
-	} else if err != nil {
+// RemoveFinalizer deletes the cluster scope resources and removes the finalizer,
+// running it again after a partial failure is safe
+func (b *Bridge) RemoveFinalizer(bi *v1alpha1.BridgeInstance) error {
+	if !containsFinalizer(bi, finalizerName) {
+		return nil
+	}
+	err := b.deleteClusterScopeResources(ownerNamespace(bi), bi.GetName())
+	if err != nil {
+		log.Errorf("Cannot cleanup clusterScope resources :%v", err)
 		return err
 	}
-
-	_, err = b.KubeClient.RbacV1().RoleBindings(bi.GetNamespace()).Get(context.TODO(), bi.GetName(), metav1.GetOptions{})
+	updated := bi.DeepCopy()
+	controllerutil.RemoveFinalizer(updated, finalizerName)
+	err = b.update(updated)
 	if errors.IsNotFound(err) {
-		rolebinding := &v1.RoleBinding{
-			ObjectMeta: metav1.ObjectMeta{
-				Name:      bi.GetName(),
-				Namespace: bi.GetNamespace(),
-				OwnerReferences: []metav1.OwnerReference{
-					{
-						APIVersion: bi.APIVersion,
-						Kind:       bi.Kind,
-						Name:       bi.GetName(),
-						UID:        bi.GetUID(),
-					},
-				},
-			},
-			Subjects: []v1.Subject{
-				{
-					Kind:      "ServiceAccount",
-					Name:      bi.GetName(),
-					Namespace: bi.GetNamespace(),
-				},
-			},
-			RoleRef: v1.RoleRef{
-				APIGroup: v1.GroupName,
-				Kind:     "Role",
-				Name:     bi.GetName(),
-			},
-		}
-		rolebinding, err = b.KubeClient.RbacV1().RoleBindings(bi.GetNamespace()).Create(context.TODO(), rolebinding, metav1.CreateOptions{})
-		if err != nil {
-			log.Errorf("Error creating RoleBinding for the KUDO Bridge %s/%s: %v", bi.Namespace, bi.Name, err)
-			return err
-		}
-		log.Infof("RoleBinding %s/%s created for ExternalService %s/%s", rolebinding.GetNamespace(), rolebinding.GetName(), bi.Namespace, bi.Name)
-	} else if err != nil {
+		// already removed
+		return nil
+	}
+	if err != nil {
+		log.Errorf("Cannot remove finalizer %s from %s/%s: %v", finalizerName, bi.GetNamespace(), bi.GetName(), err)
 		return err
 	}
+	return nil
+}
 
-	clusterRoleBindingName := fmt.Sprintf("kudobridge-%s-%s", bi.GetNamespace(), bi.GetName())
-	_, err = b.KubeClient.RbacV1().ClusterRoleBindings().Get(context.TODO(), clusterRoleBindingName, metav1.GetOptions{})
-	if errors.IsNotFound(err) {
-		clusterRoleBinding := &v1.ClusterRoleBinding{
-			ObjectMeta: metav1.ObjectMeta{
-				Name: clusterRoleBindingName,
-				Labels: map[string]string{
-					"kudobridge.dev/CRDKind":   bi.Spec.CRDSpec.GetKind(),
-					"kudobridge.dev/name":      bi.GetName(),
-					"kudobridge.dev/namespace": bi.GetNamespace(),
-				},
-			},
-			Subjects: []v1.Subject{
-				{
-					Kind:      "ServiceAccount",
-					Name:      bi.GetName(),
-					Namespace: bi.GetNamespace(),
-				},
-			},
-			RoleRef: v1.RoleRef{
-				APIGroup: v1.GroupName,
-				Kind:     "ClusterRole",
-				Name:     clusterRoleName,
-			},
-		}
-		clusterRoleBinding, err = b.KubeClient.RbacV1().ClusterRoleBindings().Create(context.TODO(), clusterRoleBinding, metav1.CreateOptions{})
-		if err != nil {
-			log.Errorf("Error creating ClusterRoleBinding for the KUDO Bridge %s/%s: %v", bi.Namespace, bi.Name, err)
-			return err
-		}
-		log.Infof("ClusterRoleBinding %s created for ExternalService %s/%s", clusterRoleBinding.GetName(), bi.Namespace, bi.Name)
-	} else if err != nil {
+// update writes the metadata and spec of the BridgeInstance, or of the ClusterBridgeInstance it was returned for
+func (b *Bridge) update(bi *v1alpha1.BridgeInstance) error {
+	if bi.IsCluster() {
+		_, err := b.Bridge.KudobridgeV1alpha1().ClusterBridgeInstances().Update(context.TODO(), bi.ClusterBridgeInstance(), metav1.UpdateOptions{})
 		return err
 	}
-
-	return nil
+	_, err := b.Bridge.KudobridgeV1alpha1().BridgeInstances(bi.GetNamespace()).Update(context.TODO(), bi, metav1.UpdateOptions{})
+	return err
 }
-func (b *Bridge) validateCRD(bi *v1alpha1.BridgeInstance) error {
-	_, err := restmapper.NewDeferredDiscoveryRESTMapper(memory.NewMemCacheClient(b.Discovery)).RESTMapping(bi.Spec.CRDSpec.GroupVersionKind().GroupKind(), bi.Spec.CRDSpec.GroupVersionKind().Version)
+
+// CollectGarbage deletes the cluster scope resources left by the BridgeInstances which don't exist anymore,
+// e.g. deleted while the bridge-controller was down or without the finalizer. The namespace passed to exists
+// is empty for a ClusterBridgeInstance.
+func (b *Bridge) CollectGarbage(exists func(namespace, name string) bool) error {
+	orphans := make(map[string][2]string)
+	clusterRoles, err := b.KubeClient.RbacV1().ClusterRoles().List(context.TODO(), metav1.ListOptions{LabelSelector: NameLabel})
 	if err != nil {
-		log.Errorf("Cannot watch the provided CRD :%v", err)
 		return err
 	}
-	return nil
-}
-func (b *Bridge) RemoveFinalizer(bi *v1alpha1.BridgeInstance) error {
-	if containsFinalizer(bi, finalizerName) {
-		err := b.deleteClusterScopeResources(bi)
-		if err != nil {
-			log.Errorf("Cannot cleanup clusterScope resources :%v", err)
-			return err
+	for _, o := range clusterRoles.Items {
+		orphans[o.GetName()] = [2]string{o.GetLabels()[NamespaceLabel], o.GetLabels()[NameLabel]}
+	}
+	clusterRoleBindings, err := b.KubeClient.RbacV1().ClusterRoleBindings().List(context.TODO(), metav1.ListOptions{LabelSelector: NameLabel})
+	if err != nil {
+		return err
+	}
+	for _, o := range clusterRoleBindings.Items {
+		orphans[o.GetName()] = [2]string{o.GetLabels()[NamespaceLabel], o.GetLabels()[NameLabel]}
+	}
+
+	for _, owner := range orphans {
+		namespace, name := owner[0], owner[1]
+		if name == "" || exists(namespace, name) {
+			continue
 		}
-		controllerutil.RemoveFinalizer(bi, finalizerName)
-		_, err = b.Bridge.KudobridgeV1alpha1().BridgeInstances(bi.GetNamespace()).Update(context.TODO(), bi, metav1.UpdateOptions{})
-		if err != nil {
-			log.Errorf("Cannot remove finalizer %s from %s/%s: %v", finalizerName, bi.GetNamespace(), bi.GetName(), err)
+		log.Infof("collecting the cluster scope resources of the deleted KUDO Bridge %s/%s", namespace, name)
+		if err := b.deleteClusterScopeResources(namespace, name); err != nil {
 			return err
 		}
-		return nil
 	}
 	return nil
 }
 
-func (b *Bridge) deleteClusterScopeResources(bi *v1alpha1.BridgeInstance) error {
-	clusterRoleName := fmt.Sprintf("kudobridge-%s-%s", bi.GetNamespace(), bi.GetName())
-	err := b.KubeClient.RbacV1().ClusterRoles().Delete(context.TODO(), clusterRoleName, metav1.DeleteOptions{})
+func (b *Bridge) deleteClusterScopeResources(namespace, name string) error {
+	objName := clusterScopeName(namespace, name)
+	err := b.KubeClient.RbacV1().ClusterRoles().Delete(context.TODO(), objName, metav1.DeleteOptions{})
 	if err != nil && !errors.IsNotFound(err) {
-		log.Errorf("Cannot delete the ClusterRole %s :%s", clusterRoleName, err)
+		log.Errorf("Cannot delete the ClusterRole %s :%s", objName, err)
 		return err
 	}
-	log.Infof("Deleted the ClusterRole %s", clusterRoleName)
-	clusterRoleBindingName := fmt.Sprintf("kudobridge-%s-%s", bi.GetNamespace(), bi.GetName())
-	err = b.KubeClient.RbacV1().ClusterRoleBindings().Delete(context.TODO(), clusterRoleBindingName, metav1.DeleteOptions{})
+	log.Infof("Deleted the ClusterRole %s", objName)
+	err = b.KubeClient.RbacV1().ClusterRoleBindings().Delete(context.TODO(), objName, metav1.DeleteOptions{})
 	if err != nil && !errors.IsNotFound(err) {
-		log.Errorf("Cannot delete the ClusterRoleBinding %s :%s", clusterRoleName, err)
+		log.Errorf("Cannot delete the ClusterRoleBinding %s :%s", objName, err)
 		return err
 	}
-	log.Infof("Deleted the ClusterRoleBinding %s", clusterRoleBindingName)
+	log.Infof("Deleted the ClusterRoleBinding %s", objName)
 	return nil
 }
 
+// referenceRules grants read access to the Secrets and ConfigMaps named by the referenceNames of the parameter mappings,
+// the other objects of the namespace can't be read
+func referenceRules(bi *v1alpha1.BridgeInstance) []v1.PolicyRule {
+	resources := map[v1alpha1.ReferenceKind]string{
+		v1alpha1.ReferenceSecret:    "secrets",
+		v1alpha1.ReferenceConfigMap: "configmaps",
+	}
+	names := make(map[v1alpha1.ReferenceKind]sets.String)
+	for _, m := range bi.Spec.ParameterMappings {
+		if _, ok := resources[m.Reference]; !ok || len(m.ReferenceNames) == 0 {
+			continue
+		}
+		if _, ok := names[m.Reference]; !ok {
+			names[m.Reference] = sets.NewString()
+		}
+		names[m.Reference].Insert(m.ReferenceNames...)
+	}
+	var rules []v1.PolicyRule
+	for _, kind := range []v1alpha1.ReferenceKind{v1alpha1.ReferenceSecret, v1alpha1.ReferenceConfigMap} {
+		if names[kind].Len() == 0 {
+			continue
+		}
+		rules = append(rules, v1.PolicyRule{
+			Verbs:         []string{"get"},
+			Resources:     []string{resources[kind]},
+			APIGroups:     []string{""},
+			ResourceNames: names[kind].List(),
+		})
+	}
+	return rules
+}
+
+// bridgeInstance returns the BridgeInstance to process, a ClusterBridgeInstance is processed as a BridgeInstance
+// of its TargetNamespace
+func bridgeInstance(ro runtime.Object) (*v1alpha1.BridgeInstance, error) {
+	switch o := ro.(type) {
+	case *v1alpha1.BridgeInstance:
+		return o, nil
+	case *v1alpha1.ClusterBridgeInstance:
+		cbi := o.DeepCopy()
+		if err := setGVKFromScheme(cbi); err != nil {
+			return nil, err
+		}
+		return cbi.BridgeInstance(), nil
+	}
+	return nil, fmt.Errorf("cannot process %T as a KUDO Bridge", ro)
+}
+
 func setGVKFromScheme(object runtime.Object) error {
 	gvks, unversioned, err := scheme.Scheme.ObjectKinds(object)
 	if err != nil {
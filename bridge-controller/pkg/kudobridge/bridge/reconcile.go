@@ -0,0 +1,313 @@
+package bridge
+
+import (
+	"context"
+
+	log "github.com/sirupsen/logrus"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/zmalik/kudo-bridge/bridge-controller/pkg/apis/kudobridge/v1alpha1"
+)
+
+// reconcileRBAC creates the ServiceAccount, roles and bindings of the CRD controller and restores their drift
+// reconcileRBAC grants the crd-controller of the BridgeInstance the access to its objects, the roles are bound to the
+// ServiceAccount of the shared crd-controller when it processes all the BridgeInstances
+func (b *Bridge) reconcileRBAC(bi *v1alpha1.BridgeInstance) error {
+	sharedAccount := ""
+	if b.SharedController {
+		sharedAccount = b.SharedServiceAccount
+	} else if err := b.reconcileServiceAccount(bi); err != nil {
+		return err
+	}
+	subject, err := controllerSubject(bi, sharedAccount)
+	if err != nil {
+		return err
+	}
+	if err := b.reconcileRole(bi); err != nil {
+		return err
+	}
+	if err := b.reconcileClusterRole(bi); err != nil {
+		return err
+	}
+	if err := b.reconcileRoleBinding(bi, subject); err != nil {
+		return err
+	}
+	return b.reconcileClusterRoleBinding(bi, subject)
+}
+
+func (b *Bridge) reconcileServiceAccount(bi *v1alpha1.BridgeInstance) error {
+	desired := desiredServiceAccount(bi)
+	client := b.KubeClient.CoreV1().ServiceAccounts(bi.GetNamespace())
+	sa, err := client.Get(context.TODO(), desired.GetName(), metav1.GetOptions{})
+	if errors.IsNotFound(err) {
+		if _, err := client.Create(context.TODO(), desired, metav1.CreateOptions{}); err != nil {
+			return err
+		}
+		log.Infof("ServiceAccount %s/%s created for ExternalService %s/%s", desired.GetNamespace(), desired.GetName(), bi.Namespace, bi.Name)
+		return nil
+	}
+	if err != nil || !metaDrifted(&sa.ObjectMeta, &desired.ObjectMeta) {
+		return err
+	}
+	updated := sa.DeepCopy()
+	mergeMeta(&updated.ObjectMeta, &desired.ObjectMeta)
+	if _, err := client.Update(context.TODO(), updated, metav1.UpdateOptions{}); err != nil {
+		return err
+	}
+	log.Infof("ServiceAccount %s/%s restored for ExternalService %s/%s", desired.GetNamespace(), desired.GetName(), bi.Namespace, bi.Name)
+	return nil
+}
+
+func (b *Bridge) reconcileRole(bi *v1alpha1.BridgeInstance) error {
+	desired := desiredRole(bi)
+	client := b.KubeClient.RbacV1().Roles(bi.GetNamespace())
+	role, err := client.Get(context.TODO(), desired.GetName(), metav1.GetOptions{})
+	if errors.IsNotFound(err) {
+		if _, err := client.Create(context.TODO(), desired, metav1.CreateOptions{}); err != nil {
+			return err
+		}
+		log.Infof("Role %s/%s created for ExternalService %s/%s", desired.GetNamespace(), desired.GetName(), bi.Namespace, bi.Name)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if !metaDrifted(&role.ObjectMeta, &desired.ObjectMeta) && equality.Semantic.DeepEqual(role.Rules, desired.Rules) {
+		return nil
+	}
+	updated := role.DeepCopy()
+	mergeMeta(&updated.ObjectMeta, &desired.ObjectMeta)
+	updated.Rules = desired.Rules
+	if _, err := client.Update(context.TODO(), updated, metav1.UpdateOptions{}); err != nil {
+		return err
+	}
+	log.Infof("Role %s/%s updated for ExternalService %s/%s", desired.GetNamespace(), desired.GetName(), bi.Namespace, bi.Name)
+	return nil
+}
+
+func (b *Bridge) reconcileClusterRole(bi *v1alpha1.BridgeInstance) error {
+	desired := desiredClusterRole(bi)
+	client := b.KubeClient.RbacV1().ClusterRoles()
+	clusterRole, err := client.Get(context.TODO(), desired.GetName(), metav1.GetOptions{})
+	if errors.IsNotFound(err) {
+		if _, err := client.Create(context.TODO(), desired, metav1.CreateOptions{}); err != nil {
+			return err
+		}
+		log.Infof("ClusterRole %s created for ExternalService %s/%s", desired.GetName(), bi.Namespace, bi.Name)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if !metaDrifted(&clusterRole.ObjectMeta, &desired.ObjectMeta) && equality.Semantic.DeepEqual(clusterRole.Rules, desired.Rules) {
+		return nil
+	}
+	updated := clusterRole.DeepCopy()
+	mergeMeta(&updated.ObjectMeta, &desired.ObjectMeta)
+	updated.Rules = desired.Rules
+	if _, err := client.Update(context.TODO(), updated, metav1.UpdateOptions{}); err != nil {
+		return err
+	}
+	log.Infof("ClusterRole %s updated for ExternalService %s/%s", desired.GetName(), bi.Namespace, bi.Name)
+	return nil
+}
+
+func (b *Bridge) reconcileRoleBinding(bi *v1alpha1.BridgeInstance, subject v1.Subject) error {
+	desired := desiredRoleBinding(bi, subject)
+	client := b.KubeClient.RbacV1().RoleBindings(bi.GetNamespace())
+	binding, err := client.Get(context.TODO(), desired.GetName(), metav1.GetOptions{})
+	if err == nil && binding.RoleRef != desired.RoleRef {
+		// the role of a binding can't be changed
+		if err := client.Delete(context.TODO(), desired.GetName(), metav1.DeleteOptions{}); err != nil && !errors.IsNotFound(err) {
+			return err
+		}
+		err = errors.NewNotFound(v1.Resource("rolebindings"), desired.GetName())
+	}
+	if errors.IsNotFound(err) {
+		if _, err := client.Create(context.TODO(), desired, metav1.CreateOptions{}); err != nil {
+			return err
+		}
+		log.Infof("RoleBinding %s/%s created for ExternalService %s/%s", desired.GetNamespace(), desired.GetName(), bi.Namespace, bi.Name)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if !metaDrifted(&binding.ObjectMeta, &desired.ObjectMeta) && equality.Semantic.DeepEqual(binding.Subjects, desired.Subjects) {
+		return nil
+	}
+	updated := binding.DeepCopy()
+	mergeMeta(&updated.ObjectMeta, &desired.ObjectMeta)
+	updated.Subjects = desired.Subjects
+	if _, err := client.Update(context.TODO(), updated, metav1.UpdateOptions{}); err != nil {
+		return err
+	}
+	log.Infof("RoleBinding %s/%s updated for ExternalService %s/%s", desired.GetNamespace(), desired.GetName(), bi.Namespace, bi.Name)
+	return nil
+}
+
+func (b *Bridge) reconcileClusterRoleBinding(bi *v1alpha1.BridgeInstance, subject v1.Subject) error {
+	desired := desiredClusterRoleBinding(bi, subject)
+	client := b.KubeClient.RbacV1().ClusterRoleBindings()
+	binding, err := client.Get(context.TODO(), desired.GetName(), metav1.GetOptions{})
+	if err == nil && binding.RoleRef != desired.RoleRef {
+		// the role of a binding can't be changed
+		if err := client.Delete(context.TODO(), desired.GetName(), metav1.DeleteOptions{}); err != nil && !errors.IsNotFound(err) {
+			return err
+		}
+		err = errors.NewNotFound(v1.Resource("clusterrolebindings"), desired.GetName())
+	}
+	if errors.IsNotFound(err) {
+		if _, err := client.Create(context.TODO(), desired, metav1.CreateOptions{}); err != nil {
+			return err
+		}
+		log.Infof("ClusterRoleBinding %s created for ExternalService %s/%s", desired.GetName(), bi.Namespace, bi.Name)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if !metaDrifted(&binding.ObjectMeta, &desired.ObjectMeta) && equality.Semantic.DeepEqual(binding.Subjects, desired.Subjects) {
+		return nil
+	}
+	updated := binding.DeepCopy()
+	mergeMeta(&updated.ObjectMeta, &desired.ObjectMeta)
+	updated.Subjects = desired.Subjects
+	if _, err := client.Update(context.TODO(), updated, metav1.UpdateOptions{}); err != nil {
+		return err
+	}
+	log.Infof("ClusterRoleBinding %s updated for ExternalService %s/%s", desired.GetName(), bi.Namespace, bi.Name)
+	return nil
+}
+
+// reconcileShared removes the crd-controller Deployment and ServiceAccount of the BridgeInstance, its CRD objects are
+// processed by the shared crd-controller
+func (b *Bridge) reconcileShared(bi *v1alpha1.BridgeInstance, status *v1alpha1.BridgeInstanceStatus) error {
+	message := "the CRD objects are processed by the shared crd-controller"
+	err := b.KubeClient.AppsV1().Deployments(bi.GetNamespace()).Delete(context.TODO(), bridgeObjectMeta(bi).Name, metav1.DeleteOptions{})
+	if err == nil {
+		log.Infof("CRD Controller deployment %s/%s deleted for the shared crd-controller", bi.GetNamespace(), bi.GetName())
+	} else if !errors.IsNotFound(err) {
+		log.Errorf("Error deleting the deployment of the KUDO Bridge %s/%s: %v", bi.Namespace, bi.Name, err)
+		setCondition(bi, status, v1alpha1.ConditionControllerDeployed, metav1.ConditionFalse, "ReconcileFailed", err.Error())
+		return err
+	}
+	// the RBAC is bound to the ServiceAccount of the shared crd-controller
+	err = b.KubeClient.CoreV1().ServiceAccounts(bi.GetNamespace()).Delete(context.TODO(), bridgeObjectMeta(bi).Name, metav1.DeleteOptions{})
+	if err != nil && !errors.IsNotFound(err) {
+		log.Errorf("Error deleting the ServiceAccount of the KUDO Bridge %s/%s: %v", bi.Namespace, bi.Name, err)
+		return err
+	}
+	setCondition(bi, status, v1alpha1.ConditionControllerDeployed, metav1.ConditionTrue, "SharedController", message)
+	setCondition(bi, status, v1alpha1.ConditionControllerAvailable, metav1.ConditionTrue, "SharedController", message)
+	return nil
+}
+
+// reconcileDeployment creates the CRD controller Deployment and restores the drift of the fields it owns.
+// A change of the watched CRD changes the pod template hash and rolls the Deployment.
+func (b *Bridge) reconcileDeployment(bi *v1alpha1.BridgeInstance) (*appsv1.Deployment, error) {
+	desired, err := desiredDeployment(bi, b.ControllerImage, b.ControllerTemplate)
+	if err != nil {
+		return nil, err
+	}
+	client := b.KubeClient.AppsV1().Deployments(bi.GetNamespace())
+	dep, err := client.Get(context.TODO(), desired.GetName(), metav1.GetOptions{})
+	if errors.IsNotFound(err) {
+		dep, err = client.Create(context.TODO(), desired, metav1.CreateOptions{})
+		if err != nil {
+			return nil, err
+		}
+		log.Infof("CRD Controller deployment %s/%s created for BridgeInstance %s/%s", dep.GetNamespace(), dep.GetName(), bi.Namespace, bi.Name)
+		return dep, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	// the replicas, e.g. scaled to 0, and the template annotations, e.g. of kubectl rollout restart, aren't owned
+	if !metaDrifted(&dep.ObjectMeta, &desired.ObjectMeta) && dep.Annotations[templateHashAnnotation] == desired.Annotations[templateHashAnnotation] &&
+		!templateDrifted(&dep.Spec.Template, &desired.Spec.Template) {
+		return dep, nil
+	}
+	updated := dep.DeepCopy()
+	mergeMeta(&updated.ObjectMeta, &desired.ObjectMeta)
+	if updated.Annotations == nil {
+		updated.Annotations = make(map[string]string)
+	}
+	updated.Annotations[templateHashAnnotation] = desired.Annotations[templateHashAnnotation]
+	template := desired.Spec.Template.DeepCopy()
+	for k, v := range dep.Spec.Template.Annotations {
+		if _, ok := template.Annotations[k]; !ok {
+			if template.Annotations == nil {
+				template.Annotations = make(map[string]string)
+			}
+			template.Annotations[k] = v
+		}
+	}
+	updated.Spec.Template = *template
+	dep, err = client.Update(context.TODO(), updated, metav1.UpdateOptions{})
+	if err != nil {
+		return nil, err
+	}
+	log.Infof("CRD Controller deployment %s/%s updated for BridgeInstance %s/%s", dep.GetNamespace(), dep.GetName(), bi.Namespace, bi.Name)
+	return dep, nil
+}
+
+// templateDrifted returns true when the fields of the pod template owned by the bridge differ: the ServiceAccount,
+// the containers and their image, command, args and env
+func templateDrifted(existing, desired *corev1.PodTemplateSpec) bool {
+	if existing.Spec.ServiceAccountName != desired.Spec.ServiceAccountName || len(existing.Spec.Containers) != len(desired.Spec.Containers) {
+		return true
+	}
+	for i, c := range desired.Spec.Containers {
+		e := existing.Spec.Containers[i]
+		if e.Name != c.Name || e.Image != c.Image || !equality.Semantic.DeepEqual(e.Command, c.Command) ||
+			!equality.Semantic.DeepEqual(e.Args, c.Args) || !equality.Semantic.DeepEqual(e.Env, c.Env) {
+			return true
+		}
+	}
+	return false
+}
+
+// metaDrifted returns true when the labels or the owner references of the desired object are missing
+func metaDrifted(existing, desired *metav1.ObjectMeta) bool {
+	for k, v := range desired.Labels {
+		if existing.Labels[k] != v {
+			return true
+		}
+	}
+	for _, ref := range desired.OwnerReferences {
+		if !hasOwnerReference(existing.OwnerReferences, ref) {
+			return true
+		}
+	}
+	return false
+}
+
+// mergeMeta adds the labels and owner references of the desired object, other labels and references are kept
+func mergeMeta(existing, desired *metav1.ObjectMeta) {
+	if existing.Labels == nil {
+		existing.Labels = make(map[string]string)
+	}
+	for k, v := range desired.Labels {
+		existing.Labels[k] = v
+	}
+	for _, ref := range desired.OwnerReferences {
+		if !hasOwnerReference(existing.OwnerReferences, ref) {
+			existing.OwnerReferences = append(existing.OwnerReferences, ref)
+		}
+	}
+}
+
+func hasOwnerReference(refs []metav1.OwnerReference, ref metav1.OwnerReference) bool {
+	for _, r := range refs {
+		if r.UID == ref.UID {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,276 @@
+package bridge
+
+import (
+	"encoding/json"
+	"fmt"
+	"hash/fnv"
+	"strconv"
+	"strings"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/zmalik/kudo-bridge/bridge-controller/pkg/apis/kudobridge/v1alpha1"
+)
+
+const (
+	// NameLabel and NamespaceLabel identify the BridgeInstance owning an object
+	NameLabel      = "kudobridge.dev/name"
+	NamespaceLabel = "kudobridge.dev/namespace"
+	crdKindLabel   = "kudobridge.dev/CRDKind"
+	// templateHashAnnotation records the hash of the desired pod template of the crd-controller Deployment
+	templateHashAnnotation = "kudobridge.dev/template-hash"
+)
+
+// The desired objects of a BridgeInstance, the reconcile loop restores any drift from them
+
+func bridgeLabels(bi *v1alpha1.BridgeInstance) map[string]string {
+	return map[string]string{
+		crdKindLabel:   bi.Spec.CRDSpec.GetKind(),
+		NameLabel:      bi.GetName(),
+		NamespaceLabel: ownerNamespace(bi),
+	}
+}
+
+func bridgeObjectMeta(bi *v1alpha1.BridgeInstance) metav1.ObjectMeta {
+	return metav1.ObjectMeta{
+		Name:      bi.GetName(),
+		Namespace: bi.GetNamespace(),
+		Labels:    bridgeLabels(bi),
+		OwnerReferences: []metav1.OwnerReference{
+			{
+				APIVersion: bi.APIVersion,
+				Kind:       bi.Kind,
+				Name:       bi.GetName(),
+				UID:        bi.GetUID(),
+			},
+		},
+	}
+}
+
+// ownerNamespace returns the namespace of the BridgeInstance, empty for a ClusterBridgeInstance
+// whose objects are created in its TargetNamespace
+func ownerNamespace(bi *v1alpha1.BridgeInstance) string {
+	if bi.IsCluster() {
+		return ""
+	}
+	return bi.GetNamespace()
+}
+
+// clusterScopeName returns the name of the ClusterRole and ClusterRoleBinding of a BridgeInstance,
+// the namespace is empty for a ClusterBridgeInstance
+func clusterScopeName(namespace, name string) string {
+	if namespace == "" {
+		// a colon can't be part of a namespace, the names of the namespaced bridges can't collide
+		return fmt.Sprintf("kudobridge:%s", name)
+	}
+	return fmt.Sprintf("kudobridge-%s-%s", namespace, name)
+}
+
+func desiredServiceAccount(bi *v1alpha1.BridgeInstance) *corev1.ServiceAccount {
+	return &corev1.ServiceAccount{
+		ObjectMeta: bridgeObjectMeta(bi),
+	}
+}
+
+func desiredRole(bi *v1alpha1.BridgeInstance) *v1.Role {
+	role := &v1.Role{
+		ObjectMeta: bridgeObjectMeta(bi),
+		Rules: []v1.PolicyRule{
+			{
+				Verbs:         []string{"get", "watch", "list"},
+				Resources:     []string{"bridgeinstances"},
+				APIGroups:     []string{"kudobridge.dev"},
+				ResourceNames: []string{},
+			},
+			{
+				// the crd-controller reports the CRDWatched condition
+				Verbs:         []string{"update"},
+				Resources:     []string{"bridgeinstances/status"},
+				APIGroups:     []string{"kudobridge.dev"},
+				ResourceNames: []string{},
+			},
+			{
+				Verbs:         []string{"get", "watch", "list", "create", "update", "patch", "delete"},
+				Resources:     []string{"operatorversions", "instances", "operators"},
+				APIGroups:     []string{"kudo.dev"},
+				ResourceNames: []string{},
+			},
+		},
+	}
+	role.Rules = append(role.Rules, referenceRules(bi)...)
+	return role
+}
+
+func desiredClusterRole(bi *v1alpha1.BridgeInstance) *v1.ClusterRole {
+	group := bi.Spec.CRDSpec.GroupVersionKind().GroupVersion().Group
+	role := &v1.ClusterRole{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   clusterScopeName(ownerNamespace(bi), bi.GetName()),
+			Labels: bridgeLabels(bi),
+		},
+		Rules: []v1.PolicyRule{
+			{
+				Verbs:         []string{"get", "watch", "list"},
+				Resources:     []string{"*"},
+				APIGroups:     []string{group},
+				ResourceNames: []string{},
+			},
+			{
+				// the KUDO Instance status is reported in the CRD status and the CRD objects get a finalizer
+				Verbs:         []string{"update", "patch"},
+				Resources:     []string{"*", "*/status"},
+				APIGroups:     []string{group},
+				ResourceNames: []string{},
+			},
+			{
+				Verbs:         []string{"get", "watch", "list"},
+				Resources:     []string{"customresourcedefinitions"},
+				APIGroups:     []string{"apiextensions.k8s.io"},
+				ResourceNames: []string{},
+			},
+		},
+	}
+	if bi.Spec.CRSelector != nil && bi.Spec.CRSelector.NamespaceSelector != nil {
+		// the crd-controller matches the labels of the CRD objects namespace
+		role.Rules = append(role.Rules, v1.PolicyRule{
+			Verbs:         []string{"get"},
+			Resources:     []string{"namespaces"},
+			APIGroups:     []string{""},
+			ResourceNames: []string{},
+		})
+	}
+	if bi.IsCluster() {
+		// the crd-controller looks the ClusterBridgeInstance up for the cluster scoped CRD objects and reports the
+		// CRDWatched condition
+		role.Rules = append(role.Rules, v1.PolicyRule{
+			Verbs:         []string{"get", "watch", "list"},
+			Resources:     []string{"clusterbridgeinstances"},
+			APIGroups:     []string{"kudobridge.dev"},
+			ResourceNames: []string{},
+		}, v1.PolicyRule{
+			Verbs:         []string{"update"},
+			Resources:     []string{"clusterbridgeinstances/status"},
+			APIGroups:     []string{"kudobridge.dev"},
+			ResourceNames: []string{},
+		})
+	}
+	return role
+}
+
+// controllerSubject returns the ServiceAccount of the crd-controller of the BridgeInstance, the ServiceAccount
+// "namespace/name" of the shared crd-controller when sharedAccount is set
+func controllerSubject(bi *v1alpha1.BridgeInstance, sharedAccount string) (v1.Subject, error) {
+	if sharedAccount == "" {
+		return v1.Subject{Kind: "ServiceAccount", Name: bi.GetName(), Namespace: bi.GetNamespace()}, nil
+	}
+	parts := strings.Split(sharedAccount, "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return v1.Subject{}, fmt.Errorf("invalid ServiceAccount %q of the shared crd-controller, expected namespace/name", sharedAccount)
+	}
+	return v1.Subject{Kind: "ServiceAccount", Name: parts[1], Namespace: parts[0]}, nil
+}
+
+func desiredRoleBinding(bi *v1alpha1.BridgeInstance, subject v1.Subject) *v1.RoleBinding {
+	return &v1.RoleBinding{
+		ObjectMeta: bridgeObjectMeta(bi),
+		Subjects:   []v1.Subject{subject},
+		RoleRef: v1.RoleRef{
+			APIGroup: v1.GroupName,
+			Kind:     "Role",
+			Name:     bi.GetName(),
+		},
+	}
+}
+
+func desiredClusterRoleBinding(bi *v1alpha1.BridgeInstance, subject v1.Subject) *v1.ClusterRoleBinding {
+	return &v1.ClusterRoleBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   clusterScopeName(ownerNamespace(bi), bi.GetName()),
+			Labels: bridgeLabels(bi),
+		},
+		Subjects: []v1.Subject{subject},
+		RoleRef: v1.RoleRef{
+			APIGroup: v1.GroupName,
+			Kind:     "ClusterRole",
+			Name:     clusterScopeName(ownerNamespace(bi), bi.GetName()),
+		},
+	}
+}
+
+// desiredDeployment returns the crd-controller Deployment, its pod template is merged with the cluster wide
+// defaults and then the BridgeInstance overrides
+func desiredDeployment(bi *v1alpha1.BridgeInstance, image string, defaults *corev1.PodTemplateSpec) (*appsv1.Deployment, error) {
+	podLabels := map[string]string{"kudobridge.dev": bi.GetName()}
+	template, err := mergePodTemplate(corev1.PodTemplateSpec{
+		ObjectMeta: metav1.ObjectMeta{Labels: podLabels},
+		Spec: corev1.PodSpec{
+			ServiceAccountName: bi.GetName(),
+			Containers: []corev1.Container{
+				{
+					Name:            controllerContainerName,
+					Image:           image,
+					ImagePullPolicy: corev1.PullAlways,
+					Env: []corev1.EnvVar{
+						{
+							Name:  "GROUP_VERSION",
+							Value: bi.Spec.CRDSpec.GetAPIVersion(),
+						},
+						{
+							Name:  "KIND",
+							Value: bi.Spec.CRDSpec.GetKind(),
+						},
+					},
+					Command: []string{"/root/crd-controller"},
+					Args: []string{
+						fmt.Sprintf("-group-version=%s", bi.Spec.CRDSpec.GetAPIVersion()),
+						fmt.Sprintf("-kind=%s", bi.Spec.CRDSpec.GetKind()),
+						fmt.Sprintf("-ns=%s", bi.GetNamespace()),
+						fmt.Sprintf("-bridge=%s", bi.GetName()),
+					},
+				},
+			},
+		},
+	}, defaults, bi.Spec.Controller)
+	if err != nil {
+		return nil, fmt.Errorf("cannot merge the controller pod template: %v", err)
+	}
+	// the selector and the RBAC rely on these
+	if template.Labels == nil {
+		template.Labels = make(map[string]string)
+	}
+	for k, v := range podLabels {
+		template.Labels[k] = v
+	}
+	template.Spec.ServiceAccountName = bi.GetName()
+
+	hash, err := templateHash(template)
+	if err != nil {
+		return nil, err
+	}
+	meta := bridgeObjectMeta(bi)
+	meta.Annotations = map[string]string{templateHashAnnotation: hash}
+
+	return &appsv1.Deployment{
+		ObjectMeta: meta,
+		Spec: appsv1.DeploymentSpec{
+			Selector: &metav1.LabelSelector{
+				MatchLabels: podLabels,
+			},
+			Template: template,
+		},
+	}, nil
+}
+
+// templateHash returns the hash of the pod template, its changes update the Deployment
+func templateHash(template corev1.PodTemplateSpec) (string, error) {
+	data, err := json.Marshal(template)
+	if err != nil {
+		return "", err
+	}
+	hash := fnv.New64a()
+	hash.Write(data)
+	return strconv.FormatUint(hash.Sum64(), 16), nil
+}
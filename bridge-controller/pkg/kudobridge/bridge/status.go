@@ -0,0 +1,87 @@
+package bridge
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kudobuilder/kudo/pkg/apis/kudo/v1beta1"
+	"github.com/kudobuilder/kudo/pkg/kudoctl/util/repo"
+	log "github.com/sirupsen/logrus"
+	appsv1 "k8s.io/api/apps/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/zmalik/kudo-bridge/bridge-controller/pkg/apis/kudobridge/v1alpha1"
+)
+
+func setCondition(bi *v1alpha1.BridgeInstance, status *v1alpha1.BridgeInstanceStatus, t v1alpha1.ConditionType, s metav1.ConditionStatus, reason, message string) {
+	status.SetCondition(v1alpha1.Condition{
+		Type:               t,
+		Status:             s,
+		ObservedGeneration: bi.GetGeneration(),
+		Reason:             reason,
+		Message:            message,
+	})
+}
+
+// setAvailableCondition reports the Available condition of the CRD controller Deployment
+func setAvailableCondition(bi *v1alpha1.BridgeInstance, status *v1alpha1.BridgeInstanceStatus, dep *appsv1.Deployment) {
+	for _, c := range dep.Status.Conditions {
+		if c.Type == appsv1.DeploymentAvailable {
+			setCondition(bi, status, v1alpha1.ConditionControllerAvailable, metav1.ConditionStatus(c.Status), c.Reason, c.Message)
+			return
+		}
+	}
+	setCondition(bi, status, v1alpha1.ConditionControllerAvailable, metav1.ConditionUnknown, "DeploymentPending", "")
+}
+
+// resolveOperator checks that the KUDO Operator exists in the repository or in the cluster.
+// The operator is only resolved again when the BridgeInstance changed.
+func (b *Bridge) resolveOperator(bi *v1alpha1.BridgeInstance, status *v1alpha1.BridgeInstanceStatus) {
+	if c := status.GetCondition(v1alpha1.ConditionOperatorResolved); c != nil && c.Status == metav1.ConditionTrue && c.ObservedGeneration == bi.GetGeneration() {
+		return
+	}
+	op := bi.Spec.KUDOOperator
+	if err := b.findOperator(bi.GetNamespace(), op); err != nil {
+		log.Errorf("Cannot resolve the KUDO Operator %s for %s/%s: %v", op.Package, bi.Namespace, bi.Name, err)
+		setCondition(bi, status, v1alpha1.ConditionOperatorResolved, metav1.ConditionFalse, "OperatorNotFound", err.Error())
+		return
+	}
+	setCondition(bi, status, v1alpha1.ConditionOperatorResolved, metav1.ConditionTrue, "OperatorFound", "")
+}
+
+func (b *Bridge) findOperator(namespace string, op v1alpha1.KUDOOperator) error {
+	if op.InClusterOperator {
+		ovn := v1beta1.OperatorVersionName(op.Package, op.Version)
+		_, err := b.KudoClient.KudoV1beta1().OperatorVersions(namespace).Get(context.TODO(), ovn, metav1.GetOptions{})
+		return err
+	}
+	repository, err := repo.NewClient(&repo.Configuration{
+		URL:  op.KUDORepository,
+		Name: "kudoBridge",
+	})
+	if err != nil {
+		return err
+	}
+	index, err := repository.DownloadIndexFile()
+	if err != nil {
+		return fmt.Errorf("cannot download the index of %s: %v", op.KUDORepository, err)
+	}
+	_, err = index.FindFirstMatch(op.Package, op.AppVersion, op.Version)
+	return err
+}
+
+// updateStatus writes the status when it changed
+func (b *Bridge) updateStatus(bi *v1alpha1.BridgeInstance, status *v1alpha1.BridgeInstanceStatus) error {
+	if equality.Semantic.DeepEqual(bi.Status, *status) {
+		return nil
+	}
+	updated := bi.DeepCopy()
+	updated.Status = *status
+	if bi.IsCluster() {
+		_, err := b.Bridge.KudobridgeV1alpha1().ClusterBridgeInstances().UpdateStatus(context.TODO(), updated.ClusterBridgeInstance(), metav1.UpdateOptions{})
+		return err
+	}
+	_, err := b.Bridge.KudobridgeV1alpha1().BridgeInstances(bi.GetNamespace()).UpdateStatus(context.TODO(), updated, metav1.UpdateOptions{})
+	return err
+}
@@ -0,0 +1,89 @@
+package bridge
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/strategicpatch"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	// DefaultControllerImage is the crd-controller image used when neither the defaults nor the BridgeInstance set one
+	DefaultControllerImage = "zmalikshxil/kudo-crd-controller:0.0.1-alpha"
+
+	controllerContainerName = "crd-controller"
+)
+
+// LoadControllerTemplate reads the cluster wide defaults of the crd-controller pod template from a YAML file
+func LoadControllerTemplate(path string) (*corev1.PodTemplateSpec, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	template := &corev1.PodTemplateSpec{}
+	if err := yaml.UnmarshalStrict(data, template); err != nil {
+		return nil, fmt.Errorf("invalid pod template %s: %v", path, err)
+	}
+	return template, nil
+}
+
+// mergePodTemplate merges the overrides into the base template in order with strategic merge semantics,
+// e.g. the containers are merged by name
+func mergePodTemplate(base corev1.PodTemplateSpec, overrides ...*corev1.PodTemplateSpec) (corev1.PodTemplateSpec, error) {
+	merged, err := json.Marshal(base)
+	if err != nil {
+		return base, err
+	}
+	for _, o := range overrides {
+		if o == nil {
+			continue
+		}
+		patch, err := templatePatch(o)
+		if err != nil {
+			return base, err
+		}
+		merged, err = strategicpatch.StrategicMergePatch(merged, patch, corev1.PodTemplateSpec{})
+		if err != nil {
+			return base, err
+		}
+	}
+	template := corev1.PodTemplateSpec{}
+	if err := json.Unmarshal(merged, &template); err != nil {
+		return base, err
+	}
+	return template, nil
+}
+
+// templatePatch returns the template as a patch, the unset fields serialized as null would otherwise delete the base fields
+func templatePatch(template *corev1.PodTemplateSpec) ([]byte, error) {
+	data, err := json.Marshal(template)
+	if err != nil {
+		return nil, err
+	}
+	var patch map[string]interface{}
+	if err := json.Unmarshal(data, &patch); err != nil {
+		return nil, err
+	}
+	return json.Marshal(withoutNulls(patch))
+}
+
+func withoutNulls(m map[string]interface{}) map[string]interface{} {
+	for k, v := range m {
+		switch val := v.(type) {
+		case nil:
+			delete(m, k)
+		case map[string]interface{}:
+			m[k] = withoutNulls(val)
+		case []interface{}:
+			for i, item := range val {
+				if itemMap, ok := item.(map[string]interface{}); ok {
+					val[i] = withoutNulls(itemMap)
+				}
+			}
+		}
+	}
+	return m
+}
@@ -1,6 +1,7 @@
 // Code generated for package crd by go-bindata DO NOT EDIT. (@generated)
 // sources:
 // config/crds/kudobridge.dev_bridgeinstances.yaml
+// config/crds/kudobridge.dev_clusterbridgeinstances.yaml
 package crd
 
 import (
@@ -77,7 +78,7 @@ func (fi bindataFileInfo) Sys() interface{} {
 	return nil
 }
 
-var _configCrdsKudobridgeDev_bridgeinstancesYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc4\x58\x4d\x6f\xdb\x46\x13\xbe\xeb\x57\x0c\x9c\x83\xdf\x00\x16\xf5\xda\x05\x8a\x42\xb7\xd4\x6e\x0b\x37\xad\x6d\x44\x4e\x2e\x41\x0e\xab\xdd\x11\x39\x35\xb9\xcb\xee\xce\x4a\x56\x8b\xfe\xf7\x62\x76\x49\x7d\x99\x6a\xec\x02\x49\x75\x49\x38\x3b\x9c\x8f\x67\x9e\x99\x1d\x7a\x34\x1e\x8f\x47\xaa\xa5\x0f\xe8\x03\x39\x3b\x05\xd5\x12\x3e\x32\x5a\x79\x0a\xc5\xc3\x77\xa1\x20\x37\x59\x9e\xcf\x91\xd5\xf9\xe8\x81\xac\x99\xc2\x65\x0c\xec\x9a\x77\x18\x5c\xf4\x1a\xaf\x70\x41\x96\x98\x9c\x1d\x35\xc8\xca\x28\x56\xd3\x11\x80\xb2\xd6\xb1\x12\x71\x90\x47\x00\xed\x2c\x7b\x57\xd7\xe8\xc7\x25\xda\xe2\x21\xce\x71\x1e\xa9\x36\xe8\x93\x87\xde\xff\xf2\xff\xc5\x45\xf1\xed\x08\x40\x7b\x4c\xaf\xdf\x53\x83\x81\x55\xd3\x4e\xc1\xc6\xba\x1e\x01\x58\xd5\xe0\x14\xe6\x9e\x4c\x89\x64\x03\x2b\xab\x31\x14\x0f\xd1\xb8\x2c\x2b\x0c\x2e\x47\xa1\x45\x2d\x8e\x4b\xef\x62\x3b\x85\x83\xd3\x6c\xa4\x8b\x2c\x67\xf5\x7d\x3a\xbd\xee\xec\xa5\x83\x9a\x02\xbf\x1d\x38\xfc\x85\x02\x27\x85\xb6\x8e\x5e\xd5\x4f\x62\x49\x67\x81\x6c\x19\x6b\xe5\x0f\x4f\x47\x00\x41\xbb\x16\xa7\x70\x23\x21\xb4\x4a\xa3\x19\x01\x2c\x55\x4d\x26\x25\x9c\x83\x72\x2d\xda\x37\x77\xd7\x1f\xbe\x99\xe9\x0a\x1b\x95\x85\x00\x06\x83\xf6\xd4\x26\x3d\xe8\xe3\x01\x0a\xc0\x15\x42\x56\x85\x85\xf3\xe9\x71\x13\x0f\xbc\xb9\xbb\x2e\x3a\x03\xad\x77\x2d\x7a\xa6\x3e\x79\xf9\xed\xd4\x7f\x23\x3b\x70\x75\x2a\xb1\x64\x1d\x30\x52\x71\xcc\x2e\xbb\xba\xa1\x81\x90\x9d\xbb\x05\x70\x45\x01\x3c\xb6\x1e\x03\xda\xcc\x81\x1d\xb3\x20\x2a\xca\x82\x9b\xff\x86\x9a\x0b\x98\xa1\x17\x23\x10\x2a\x17\x6b\x23\x34\x59\xa2\x67\xf0\xa8\x5d\x69\xe9\x8f\x8d\xe5\x00\xec\x92\xcb\x5a\x31\x76\xf8\xf7\x3f\xb2\x8c\xde\xaa\x5a\x50\x8c\x78\x06\xca\x1a\x68\xd4\x1a\x3c\x8a\x0f\x88\x76\xc7\x5a\x52\x09\x05\xfc\xea\xbc\x40\xb4\x70\x53\xa8\x98\xdb\x30\x9d\x4c\x4a\xe2\x9e\xf1\xda\x35\x4d\xb4\xc4\xeb\x49\xe2\x2d\xcd\x23\x3b\x1f\x26\x06\x97\x58\x4f\x02\x95\x63\xe5\x75\x45\x8c\x9a\xa3\xc7\x89\x6a\x69\x9c\x02\xb7\x89\xf0\x45\x63\x5e\xf9\xae\x3d\xc2\xe9\x4e\xa4\xbc\x96\xba\x07\xf6\x64\xcb\x8d\x38\xd1\xef\x28\xee\xc2\x3f\xa9\xaf\xea\x5e\xcb\xf1\x6f\xe1\x15\x91\xa0\xf2\xee\x87\xd9\x3d\xf4\x4e\x53\x09\xf6\x31\x4f\x68\x6f\x5f\x0b\x5b\xe0\x05\x28\xb2\x0b\xf4\xb9\x70\x0b\xef\x9a\x64\x11\xad\x69\x1d\x59\x4e\x0f\xba\x26\xb4\xfb\xa0\x87\x38\x6f\x88\xa5\xd2\xbf\x47\x0c\x2c\xf5\x29\xe0\x32\xf5\x3d\xcc\x11\x62\x6b\x14\xa3\x29\xe0\xda\xc2\xa5\x6a\xb0\xbe\x54\x01\xbf\x38\xec\x82\x70\x18\x0b\xa4\x9f\x07\x7e\x77\x5c\xed\x2b\x66\xb4\x36\xe2\x7e\x96\x0c\x56\x68\x7f\x34\xcc\x5a\xd4\x7b\x0d\x62\x30\x90\x17\x12\xb3\x62\x14\xea\xf7\x9a\xc5\x8e\xc1\xa1\xa6\x4c\x33\xd3\x9b\xd9\x81\xef\xa3\x41\x42\x1e\x1a\x5e\xb1\xf3\x03\xdd\xfc\x24\xee\xdb\x7d\xdd\x94\x24\x2d\x08\x85\x6a\x1e\x17\xe8\x51\x26\x0b\x3b\x61\x5e\x3e\xd2\xf0\xf6\xfd\xd5\xed\x81\x4d\x78\x62\xa8\x6b\xec\x03\xbd\x63\x29\xc2\xd1\xf9\x33\x18\xf5\x9b\xbb\xeb\x7e\xe6\xe4\x51\x83\x7d\xb0\x4f\x3c\xc2\xb1\xba\xf7\xbf\x05\x61\x6d\xee\x14\x57\x9f\xf5\x7a\x7a\xbd\xc8\x6e\x52\x07\x26\x4c\x5a\x42\x8d\x7b\xa3\x2c\xcd\x5b\x54\x26\x0b\x07\x4c\x02\x08\x51\x3d\x76\xfa\x67\xb9\xdf\xba\xb6\xde\x8e\x3f\x56\x64\x41\xe5\x0b\x01\x7e\x9e\xdd\xde\x4c\x7e\x72\x39\xd6\x41\x9b\x4a\x6b\x0c\x21\xf3\xab\x41\xcb\x67\x10\xa2\xae\x40\x85\x9e\x7a\x33\x39\x29\x1a\x65\x69\x81\x81\x8b\xce\x03\xfa\xf0\xf1\xe2\xd3\x10\x66\x00\x3f\x3a\x0f\xf8\xa8\x9a\xb6\xc6\x33\xa0\x8c\xf2\x66\x80\xf4\xcc\xa0\x90\x81\xd8\xd8\x83\x15\x71\x45\xc3\x89\x2b\x68\x9d\xe9\x12\x5e\xa5\x44\x59\x3d\x20\xb8\x2e\xd1\x88\x50\xd3\x03\x4e\xe1\x44\xa8\xb6\x13\xe2\x9f\x72\x4d\xff\x75\x32\x68\xf3\x7f\xab\x0a\x3d\xc2\x89\xa8\x9c\xe4\xc0\x36\x77\x84\xc8\x7a\x7e\x6c\x03\xe4\x4a\x31\xb0\xa7\xb2\x44\x8f\xc3\x68\xa6\xc1\x27\x03\xe5\x35\x38\x2f\xb9\x5b\xb7\x63\x20\x99\x95\x9a\x75\xbd\x62\x9e\x04\xfc\xf1\xe2\xd3\x91\x68\xf7\x71\x02\xb2\x06\x1f\xe1\x02\xc8\x66\x54\x5a\x67\x5e\x17\x70\x9f\x18\xb1\xb6\xac\x1e\xc5\x8f\xae\x5c\x40\x0b\xce\xd6\xeb\xe1\x68\x1d\x54\x6a\x89\x10\x5c\x83\xb0\xc2\xba\x1e\xe7\xd1\x63\x60\xa5\xd6\x92\x7f\x5f\x2e\x61\x98\x82\x56\x79\xde\xbf\x7d\x07\xad\xde\xdf\x5e\xdd\x4e\x73\x54\x42\xa1\xd2\x4a\x28\x32\xd5\x17\x24\x77\xac\x5c\xae\xf9\xa6\x10\x4e\x26\x38\x62\x26\x07\x3b\xd0\x95\xb2\x25\x0e\x9a\x4d\x99\x22\x2c\xa2\xcc\xee\xe2\xf4\xa5\xdd\x7a\x78\x4d\xf6\xbf\x81\xeb\xf2\x70\x30\xfc\x47\x97\xce\xb3\xd2\x4a\xcb\xec\x67\xd3\xba\xd9\xe1\xf3\x3f\xa6\x25\x6b\xb5\xb7\xc8\x98\x32\x33\x4e\x07\x49\x4a\x63\xcb\x61\xe2\x96\xe8\x97\x84\xab\xc9\xca\xf9\x07\xb2\xe5\x58\x88\x38\xce\x4c\x08\x93\xb4\x10\x4f\x5e\xa5\x7f\xfe\x55\x16\x69\x95\x7d\x5e\x2a\x49\xf5\x6b\xe4\x23\x7e\xc2\xe4\xc5\xe9\xf4\x7b\xd4\x73\x6f\xa5\xd3\x59\x7f\x43\x1e\xbc\x29\x2d\xb1\xaa\x48\x57\xfd\x52\xbc\x9d\x9e\x83\x3d\xd2\x28\x93\x47\xae\xb2\xeb\x2f\x4e\x5b\x01\x32\x7a\x89\x67\x3d\xee\xbe\xce\xc6\xca\x1a\xf9\x7f\xa0\xc0\x22\x7f\x31\x72\x91\x9e\xd1\xa4\xef\xaf\xaf\xbe\x0e\x99\x23\xbd\xb8\x23\x8f\xec\x56\xc3\x7b\x21\x2b\x8e\xe1\xb9\x9b\x61\x52\xde\xdb\x0d\xdd\x3c\xc8\x0e\x3e\xb0\x1c\x3e\x63\x37\x9c\x0f\x58\x1f\x5e\x14\x9f\xe4\x39\x90\xcc\x81\x68\xfb\x39\x7e\xae\xea\xb6\x52\xe7\x5b\x59\x72\x32\xee\x3e\xc2\x77\x8e\x01\x72\x32\x53\x60\x1f\x73\x02\x81\x9d\x57\x25\x76\x92\x2d\x5a\xb2\xb2\xb4\x8c\xe6\xe6\xf0\x1b\xfc\xe4\x64\xef\xb3\x3a\x3d\x6a\x67\x0d\xe5\xbf\x23\xc0\xc7\x4f\xa3\x6c\x15\xcd\x87\x3e\x1a\x11\xfe\x1d\x00\x00\xff\xff\xfb\x12\xb8\x83\xc6\x10\x00\x00")
+var _configCrdsKudobridgeDev_bridgeinstancesYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x5d\x5b\x6f\x1c\xb9\xb1\x7e\x9f\x5f\x51\xd0\x79\xf0\xcb\xcc\xd8\xce\x06\x07\x07\x83\x93\x00\x8a\xe4\x5d\x08\xf6\x7a\x05\x49\xbb\x79\x58\xe4\x81\xd3\x5d\x33\xc3\xa3\x6e\xb2\x0f\xc9\x96\x3c\xd9\xd5\x7f\x0f\x8a\x97\xbe\x4d\xdf\x66\x24\xc5\x70\xb6\xa3\x00\x6b\x35\x6f\x55\x1f\x8b\xc5\x62\x91\x9f\x3d\x5b\x2c\x16\x33\x96\xf1\x5f\x50\x69\x2e\xc5\x0a\x58\xc6\xf1\x8b\x41\x41\xbf\xe9\xe5\xfd\xff\xe8\x25\x97\x6f\x1f\xde\xaf\xd1\xb0\xf7\xb3\x7b\x2e\xe2\x15\x5c\xe4\xda\xc8\xf4\x06\xb5\xcc\x55\x84\x97\xb8\xe1\x82\x1b\x2e\xc5\x2c\x45\xc3\x62\x66\xd8\x6a\x06\xc0\x84\x90\x86\xd1\x67\x4d\xbf\x02\x44\x52\x18\x25\x93\x04\xd5\x62\x8b\x62\x79\x9f\xaf\x71\x9d\xf3\x24\x46\x65\x47\x08\xe3\x3f\xbc\x5b\x7e\xb7\x7c\x37\x03\x88\x14\xda\xe6\x77\x3c\x45\x6d\x58\x9a\xad\x40\xe4\x49\x32\x03\x10\x2c\xc5\x15\xac\x15\x8f\xb7\xc8\x85\x36\x4c\x44\xa8\x97\xf7\x79\x2c\xdd\xb7\x65\x8c\x0f\x33\x9d\x61\x44\x03\x47\x52\x84\xbe\xad\x18\xda\x28\x66\x70\xbb\x5f\xc1\xdf\x71\xbd\x93\xf2\xde\x7e\x7d\x74\x7f\xbe\x48\x38\x0a\x73\x21\xc5\x86\x6f\xa9\x31\xfd\x68\x54\x0f\x3c\xc2\xf0\x6b\x18\x9e\x86\x5b\xb8\xf1\x16\xbe\x75\xad\x86\xce\x58\x14\xaa\xe9\xbd\x36\x98\x16\xc5\x19\x33\xbb\x15\xbc\x75\x92\x99\x19\xc0\x56\xc9\x3c\x5b\x41\x43\x03\xdf\x8f\x1b\xd8\x21\xff\x37\x3b\xde\x95\xd7\xd9\x16\x24\x5c\x9b\x8f\x2d\x85\x9f\xb8\xa6\xae\x01\xb2\x24\x57\x2c\x39\xc0\xcb\x96\x69\x2e\xb6\x79\xc2\x54\xb3\x74\x06\x90\x29\x24\xcd\xf1\x67\x71\x2f\xe4\xa3\xf8\x9e\x63\x12\xeb\x15\x6c\x58\xa2\x71\x06\xa0\x23\x99\xe1\x0a\x3e\x07\x4d\x63\xfa\x96\xaf\x95\x37\x0a\x3f\xe7\xda\x30\x93\xeb\x15\xfc\xf6\x34\x03\x08\xd3\x00\x0f\xef\x59\x92\xed\xd8\xfb\xf2\x9b\xad\xbe\xf0\xc8\x56\x8a\x69\x9c\x1d\xa6\x2c\x80\x2f\x33\x14\xe7\xd7\x57\xbf\x7c\x77\x5b\xfb\x0c\x10\xa3\x8e\x14\xcf\xc8\x5e\x56\x10\x20\x00\xae\xc1\xec\x10\x5c\x65\xd8\x48\x65\x7f\x2d\x20\x80\xf3\xeb\xab\x65\xd1\x45\xa6\x64\x86\xca\xf0\x80\xb8\xfb\xa9\x2c\x8d\xca\xd7\xc6\x80\x6f\x48\x26\x57\x0b\x62\x5a\x0e\xe8\x06\xf6\xda\x61\xec\xd5\x00\xb9\x01\xb3\xe3\x1a\x14\x5a\x78\x85\x5b\x20\xf4\x99\x09\x90\xeb\xff\xc3\xc8\x2c\xe1\x96\x60\x57\x1a\xf4\x4e\xe6\x49\xec\x0d\xd8\x80\xc2\x48\x6e\x05\xff\x67\xd1\x9b\x06\x23\xed\x30\x09\x33\xa8\x0d\x70\x61\x50\x09\x96\xc0\x03\x4b\x72\x9c\x03\x13\x31\xa4\x6c\x0f\x0a\xa9\x5f\xc8\x45\xa5\x07\x5b\x45\x2f\xe1\x47\xa9\x10\xb8\xd8\xc8\x15\xec\x8c\xc9\xf4\xea\xed\xdb\x2d\x37\x61\xd9\x47\x32\x4d\x73\xc1\xcd\x9e\x6c\xd5\x28\xbe\xce\x8d\x54\xfa\x6d\x8c\x0f\x98\xbc\xd5\x7c\xbb\x60\x2a\xda\x71\x83\x91\xc9\x15\xbe\x65\x19\x5f\x58\x61\x05\x29\xa5\x97\x69\xfc\x5f\x85\x39\xbc\xa9\x81\x67\xf6\x64\x3b\xda\x28\x2e\xb6\x95\x02\x6b\xe3\x3d\x28\x93\x99\xd3\x9c\x32\xdf\xd4\x29\x5a\x82\x49\x9f\x08\x8f\x9b\x0f\xb7\x77\x10\x86\x76\x80\x3b\x6c\xcb\xaa\xba\x84\x99\x20\xe2\x62\x83\x64\x1c\x5c\xc3\x46\xc9\xd4\xa2\x8a\x22\xce\x24\x17\xc6\xfe\x12\x59\xc7\x40\x16\x9e\x72\x43\xf3\xf7\xff\x39\x6a\x43\x33\xb0\x84\x0b\xeb\xea\x60\x8d\x90\x67\x31\x33\x18\x2f\xe1\x4a\xc0\x05\x4b\x31\xb9\x60\x1a\x5f\x1d\x64\x42\x53\x2f\x08\xbc\x71\x30\x57\xbd\x74\xf9\x3f\x57\xd9\xe1\x54\x29\x08\x6e\xb4\x63\x4e\xea\x3e\xe7\x36\xc3\xa8\xb6\x00\x62\xd4\x5c\x91\xc1\x1a\x66\x90\xcc\x3c\xd4\x2c\x97\x5d\xf7\xd2\xab\x6f\x1a\xcd\x92\x86\x20\x17\x45\x45\x2b\x32\xdf\x70\x24\x43\xc9\x64\x0c\x06\xd3\x8c\x96\x08\xa4\xa8\xb6\x18\xd3\x3a\x71\xeb\xe6\xe2\xe6\xb2\x32\x80\xad\xfb\xc8\xcd\x2e\x6c\x11\x3c\x72\x2d\x40\x63\xca\x84\xe1\x91\x9e\x03\x2e\xb7\x4b\x5a\x76\x1a\x9d\x5d\xf0\x94\x6d\x71\x5e\x18\x9b\x9e\x83\x90\x31\xde\x62\x82\x91\x91\x0a\xa4\x02\x8d\x51\xae\xb8\xd9\x93\x80\xf8\xc5\x10\x08\xd4\x30\x52\xf1\xa2\x32\x36\xfd\x91\x71\x81\xea\x40\xcb\x8e\x79\x71\xff\xff\xb2\xa0\x6d\x54\x09\x34\xa8\x17\xc1\x63\x2f\x72\xe7\xb2\x17\x1b\xef\xb3\x8d\xca\xb1\xd1\x34\x52\x41\xc6\x21\x5c\x6f\x0a\x65\x4a\x5c\x03\x78\x4e\x2a\x0d\x99\x92\x11\x6a\x8d\x31\xac\xf7\x16\x16\xb7\x9b\xcc\x81\x25\x89\x57\x38\x85\xc7\x1d\x0a\xa0\x85\xa2\xd1\x3a\xb9\x07\x54\x2c\xf1\xfb\x8e\x0e\xb0\x68\x96\x22\x90\x29\x83\xce\x12\x6e\x0e\x46\xb2\xf3\x63\x76\xc8\x09\x58\x27\x97\xb6\x8e\x2e\x53\x5c\x2a\x4e\x26\x54\x37\xad\x7e\xf3\xa2\x9f\x84\xad\x31\xe9\x46\xe3\x00\x91\x4f\xd5\xfa\x15\x63\x23\x51\x6d\x5f\x85\x32\x15\xc1\x5b\xbb\xed\x97\x8b\x7e\x52\x66\xa2\xdd\x87\x2f\x34\xb3\xc5\x36\x09\x30\x28\x62\xb3\x99\x73\x9a\x14\x29\x90\x68\x56\xc8\x02\x3e\xeb\xcb\xb8\xc2\xd4\x79\xc5\xbb\x1d\xd6\xbe\x00\x53\x08\xe7\x9f\x2f\x31\x5e\xce\x3a\x86\x06\x6e\x30\xed\x11\xad\x21\xdc\x79\x8f\x00\xde\xbb\x87\x12\xb3\x63\x26\x2c\x0d\xed\xbc\xbd\x9e\x03\x83\x7b\xdc\xbb\xed\x8d\x76\xcd\x0c\x15\x2b\x2a\x2b\xa4\x95\xee\x2c\xf4\x1e\xf7\xb6\x92\xdf\xeb\x7a\xe4\x1b\x9e\x08\xbf\x41\xe1\xbe\xbf\x42\x43\x55\x92\xc0\xc7\x20\x4e\x67\xfa\x60\xe5\x24\xf9\x0a\x00\x58\x96\x25\x76\x59\xc9\x6e\x8c\x7b\x3d\xfa\xe1\x4f\x40\xe5\x28\x71\x0b\x28\xcb\x6d\xd2\x81\xfd\x86\x76\xbc\xc4\xc6\x2a\x7a\xc7\x33\x72\x80\x34\x4b\xd6\x99\x85\x48\xe2\x17\x96\xf0\xb8\x18\xd7\xd9\xcd\x95\x98\xc3\x67\x69\xe8\x3f\x1f\xbe\x70\xda\x2f\x69\x3e\x2e\x25\xea\xcf\xd2\xd8\x2f\x2f\xa6\xb0\x13\xe3\x28\x75\x5d\x13\x6b\x72\x02\x98\x52\x6c\x4f\xfa\x54\x43\x0b\xbd\x84\x2b\xe7\x97\x0a\x68\xb8\xa6\xcd\x5d\xaa\xa0\x17\x15\xfa\x8e\x5c\x17\x69\xae\x6d\x2c\x20\xa4\x58\x60\x9a\x99\x7d\x6b\x1f\x1e\x0e\xa9\x6a\x68\xf4\x74\xe7\xbb\xba\xa3\x60\xc6\x0d\xe4\xc2\xc8\x84\x45\x18\x43\x9c\x5b\xa1\xd9\xc1\xd6\x95\x91\x27\x18\x02\x79\x70\xfd\x1e\x39\x17\xa1\xaa\x95\xb3\xa7\xa6\x5f\xf8\x8d\x98\xaf\xfe\xb3\x20\xfb\xeb\x2d\x0f\xb0\xf6\x54\xea\xdd\x43\xc7\xcb\x6c\xdd\xaa\x75\xff\x3d\x68\xb1\x38\xb6\x87\x61\x96\x5c\x8f\xf2\x2a\xa3\x50\xad\xd9\x6d\x45\x0c\x6b\xbc\x90\xb2\x8c\x2c\xf7\x37\x72\x8b\xd6\x78\x9e\x20\x63\x5c\xe9\x25\x9c\xdb\x13\x5e\x82\xb5\x32\x2e\xac\x99\x55\xbb\xa1\x1e\xb8\x06\x9a\x8f\x07\x96\x90\x23\xa6\x25\x2e\x00\x13\xe7\x96\xe5\xe6\x60\x2b\x9a\xc3\xe3\x4e\x6a\xe7\x65\x6d\xa0\x41\xb2\x9c\xdd\xe3\xfe\x6c\x7e\x60\xed\x67\x57\xe2\xcc\x39\xec\x03\xfb\x2e\xbc\xbb\x14\xc9\x1e\xce\x6c\xd9\xd9\x69\xdb\xd0\xe0\x2c\x0f\x54\x28\x4e\xed\x47\x04\x03\x67\x9f\x9b\x8d\x7a\x23\x82\x62\x88\x96\x10\x61\xee\x0f\x1a\xb9\x36\xa8\xdc\xf9\x3a\x0e\x65\x10\x4b\xf1\xc6\xb8\x39\x00\x6e\xce\x66\xa7\xed\x62\xcd\x39\x5c\x1d\x61\x70\x53\x38\x31\x85\x13\x53\x38\x31\x85\x13\x53\x38\x31\x85\x13\x53\x38\x31\x18\x4e\xf4\x14\x46\x2a\xbe\x3d\xc8\xaa\x1d\x4c\xcb\xc5\xcd\x25\xd5\x6a\x84\x13\x14\x2f\x18\x09\x8f\x84\xde\x31\x63\x3e\x23\x55\x14\x63\x82\xe4\xaa\xaf\x65\xc2\xa3\xfd\x80\xd4\x97\xb5\xca\x15\xe1\x1f\x69\xef\xdd\xb1\x2c\x43\x51\xe4\xad\x3f\xfe\x7c\xf9\x53\x91\x11\x74\xf9\x21\x4a\xae\x96\x31\x11\xd9\x80\x1d\x1e\xe3\x39\xe5\x15\x59\x9e\xd8\x9c\xab\x1b\x06\x3b\x00\x68\x35\xfe\x90\xf4\xa7\x78\x6d\x40\x87\x20\x11\x55\xad\x68\xc0\xe0\x07\x59\xe6\x12\xe5\xa6\x45\x05\x0a\xef\x00\x69\x5d\x50\x1a\x18\xd8\x96\x4c\xb1\x99\xc4\xb2\xe9\xad\xb9\xbd\xeb\x98\x97\x37\x45\x73\x77\x09\x64\xad\xda\x5f\x1b\xf8\x6c\xe3\x6f\xbf\xc1\xd2\x66\xbe\x7f\x87\x44\x3e\xa2\x82\xa7\xa7\x05\x7d\xb3\xe2\x3d\x3d\x2d\xe1\xb2\x02\x4c\x63\x28\xea\xde\x05\x61\x16\xeb\x42\xfa\x4d\x2e\x22\x9a\x30\xb7\x10\xd8\x03\xe3\x09\x5b\x27\xb8\x3c\x06\x51\xba\xad\xfa\xc9\xaf\xca\x01\x44\x69\xf0\x50\xb5\x82\x68\x01\x60\x28\x9b\x1d\x17\x49\xb1\x84\x00\x89\xcf\xb3\xcc\x5f\xbe\x74\x78\xc6\x9a\x2c\xe7\x07\x8d\x1a\x12\x69\x4c\x1f\x50\x81\x62\x62\x5b\x4c\xb3\x0d\xa9\x22\x9b\x00\x29\xae\xac\x1a\xd3\xaa\x21\x62\x02\x32\x2e\x8a\x1c\x65\xe3\x3e\xef\x2d\xcb\xb2\x85\x6f\x5c\xb9\x1d\x75\xd3\x53\xfe\x4e\x36\xef\x6e\x6c\x30\xae\xe7\x4c\x5b\x75\xeb\x99\xa0\x0a\x44\xc7\xe3\x73\x04\x38\x27\x03\x12\x7c\x7a\x0b\x2a\xde\xf6\xff\xfa\x97\x77\xcb\xf7\xcb\x77\x73\xf8\x5f\x7b\x2b\xfc\xca\x58\x15\x26\x31\x06\xa6\xa2\x72\x9f\x41\xc3\x79\xc5\x70\x7c\xfd\x53\x44\xe3\xe2\xc2\x9d\x0b\x43\xc7\x23\x24\xbc\x6a\xb6\x21\xb4\x72\x4a\xd2\x1b\x69\x2f\x2c\x92\x07\xba\xfa\x0b\x07\xce\x30\x19\xad\x1d\x3b\x2b\x5b\x4b\x99\x20\x13\x2d\x35\x32\x16\xdd\xb3\x2d\x8e\x90\xea\xda\xd5\x6c\x03\xcd\x77\x62\x7d\xe2\x29\x20\x29\xcc\xa4\xe6\x46\xaa\xfd\x08\x39\xc8\xef\xdc\x14\x0d\xda\xc4\xa9\x94\xfe\x7c\xf3\xe9\x14\x81\xbc\x59\x8f\x90\x66\x8c\x29\x9d\x6c\x3e\x3d\x61\x41\xc6\x14\x4b\xd1\xa0\xfa\x91\x65\x19\x17\xdb\x16\x07\x51\x93\xf3\xba\x59\xbf\x22\xf1\x4e\x3e\x16\x1e\xc0\x85\x13\x73\x67\x5e\x71\xb8\x97\x0d\x17\x8f\x73\xbb\xeb\xa4\x14\x0c\xc4\xb5\x58\xa0\xd0\xb5\x90\x4b\x2f\xe1\xef\xb4\xae\xfd\x01\x72\xee\x43\x4d\x3f\x38\x75\x63\x2d\x3a\xc4\x82\xf6\xfc\xb2\x93\x49\x4c\x17\xbb\x5c\x14\x41\x14\x55\xe4\x5b\x21\x55\x5b\xc0\xd7\x79\x5e\xe9\x55\x9d\xb2\x6e\x14\x2d\x5b\x55\x83\x2b\xb4\x71\x19\xc6\x21\x4c\x63\x5d\x6a\xcd\x8e\x4f\x1a\xf8\xf0\xa7\xbd\xb0\x21\xab\x8f\x08\x1a\xe6\x44\x71\x89\xc7\xcb\xee\x2b\xb5\xc9\x22\xe7\xe0\x9d\x27\xf8\x77\x12\x45\xb4\xa0\x50\x58\x40\x85\x74\xc1\x76\x87\x08\x03\xab\x01\xec\xd5\xfa\x28\xf9\xbf\x57\x32\x6d\x08\x4f\x6f\x66\x02\xca\x85\xd0\x7e\x97\x58\x52\xd5\xa5\xe6\xff\x44\x12\x7d\x19\xcc\x6c\x59\x6e\x14\xfa\xd7\x33\xda\x7b\xec\xae\xb3\x91\xf2\xec\x1f\xa7\xaa\xa0\x70\x83\x0a\x45\xf5\x39\x50\x8f\x1e\x37\xa1\x76\x4d\x19\x66\xea\x5a\x94\x9d\xfa\xfc\x08\xe9\xc9\xe0\x16\x23\x85\x86\x34\x72\xef\x91\x7e\x64\xd9\x1c\x12\x7e\x4f\x17\x47\x54\xf2\x11\xf7\x37\xb8\x71\x2b\xa2\xe8\xc1\xa7\xa7\x68\x36\x33\xa6\xbd\xb3\xa7\x1a\x7d\xa6\x77\x9c\xe6\x9f\xcb\x47\x49\x63\xd5\xb7\x4d\x1a\x13\x4a\x5e\xbe\xc8\x8b\x3a\x5d\x75\x4d\xd9\x32\x9e\xb0\x73\x6d\xa3\x89\x42\x88\x79\xdb\x05\x3e\xf7\xc7\x3e\x1f\xf4\x90\xea\x0a\x99\xf5\x0c\xe9\xac\x55\xdc\xa1\x5c\xc5\x20\x2a\xc3\xe7\xfc\xa1\xac\x44\x03\x31\x57\xb9\x82\x15\x6f\xd8\xfc\xc1\xea\x74\x13\x1e\x12\x3b\xb4\x7e\x7d\x24\x14\x4e\x4c\xd6\x18\x94\x7c\xe0\x31\xc6\x1d\x42\x0c\xed\xef\x50\x0c\x37\x4a\x8d\xbb\x20\x5b\xd7\xe9\x69\xf0\x8c\x44\xe7\x26\x9a\x3a\xb9\xb1\xce\xc0\x2f\x74\x3a\xf6\x50\x97\xcb\x14\x53\xda\xb5\x7f\x87\x34\x4f\xe0\xfd\xbb\x3f\xfd\x19\x9e\x9e\x3e\xf2\x67\x9d\x74\x46\x4e\xb8\x91\xe3\x10\x90\xbd\x7b\x79\xb1\x1a\xbb\x83\x9d\x41\x59\xfa\x0c\x6b\x01\x46\xb6\x7c\xee\x4d\x10\x74\x1b\x72\x65\x57\xbd\xdd\x0b\xc3\xbe\xac\x66\xbd\xca\x5f\x37\xeb\xb7\x44\x09\xb5\x9d\x9a\xa6\xe6\x51\x71\x63\x50\x54\x76\xed\xfa\x91\xff\x96\x6f\x79\x32\x3b\x02\x23\xff\x22\x64\x28\x63\x71\xed\xab\x55\x64\x7c\xdc\xf1\x68\xe7\x5f\xa6\x14\x6f\x5b\xc8\x84\x2b\x06\xea\x2e\x15\xdc\x8b\x17\x5d\x7f\xcb\xe2\x7c\xd3\x8e\x6f\x77\xf4\x2a\x2f\x88\x01\x8f\x5c\xe8\x03\x51\x36\x52\xa5\xcc\xac\xe8\x4d\xd2\x77\x7f\x3a\x28\x75\xda\xd1\xbb\xbe\xed\x81\xe3\x76\x4f\x2b\x43\x1c\x36\xa0\xe4\x6d\xad\x72\xcb\x74\xd4\xf3\x18\xae\x6f\x9f\x12\x96\x8a\xd6\xa9\xcf\xf2\x11\x02\xae\x74\x36\xda\x95\x76\x4b\x12\x62\x28\xf2\x06\x1d\x29\x15\x4a\x1c\x06\xc7\xb7\x19\x12\x61\x38\x8a\x1a\x1d\x82\xbc\x69\x89\x41\xea\x92\xf9\x57\x96\xc8\xcd\x0e\x15\x30\xaf\xd9\xad\x7d\x07\x16\x9c\x74\x88\x5a\x98\x28\xdb\xd5\x23\x17\xdb\x68\x99\x25\x4c\xb8\xf6\xcb\x18\xb3\x44\xee\x7d\xc1\x9b\x59\x8b\x9c\xfd\x66\xff\x3c\x37\xd5\x0c\xb3\xbc\x25\xb4\xca\xbc\x63\xfa\x55\x5c\x17\xcd\xd1\xab\xfb\x34\x9d\xeb\x0c\x9b\xcf\x4d\x0f\xf0\xb9\x75\xb5\x40\x1b\x99\xe9\xb6\x90\x83\x64\xb5\x9b\x15\x4b\x12\x3a\x14\x48\xe5\x1e\x81\x86\x23\x4f\xcd\x64\xbc\x67\xa0\x1e\xa9\xbc\xdc\x04\xa2\x1d\x25\x9b\xdc\x16\xd5\xb6\xe2\x42\x42\xc5\xcf\x06\x9d\x76\x6c\x46\x0a\x63\x90\x64\x52\xe5\x93\x3b\xb7\x66\x75\x9e\xb6\x6c\xf5\x7d\x9b\xfc\x03\x5d\xb7\x55\x5f\xeb\x77\x42\xf2\x4b\x59\xb3\x61\x3b\x17\x1f\x3e\x81\xca\x13\xd4\x07\x72\xdb\xe0\x44\x33\xc3\xf5\x66\x0f\x6b\xdc\xd0\xa3\x63\xf7\x88\xaf\xc0\xc0\x29\x5f\xc6\xac\x04\xdc\x89\x2e\xa6\x94\xf0\x26\x4f\xb0\x78\x8f\xca\xac\x80\x58\x5c\x39\x0c\x06\x21\x27\xb8\x97\x14\xb5\xee\xcc\x83\x34\xc4\xfc\xd1\xd5\x6d\x80\xe8\x7b\x28\xcd\xa0\x38\xaa\x11\xb4\xc5\x29\xcd\x62\xc9\x9b\x69\xf1\x50\xed\xb4\x55\x09\x76\xf6\x46\xc9\xfe\xc6\x22\x7b\x38\xfd\x25\xba\xf3\x83\x88\x4e\xc3\x5a\xe6\x74\x4e\xa7\xd7\xb3\xc9\xc6\x7b\x13\xfa\x63\xe5\xfc\xf6\xd7\xbf\xc0\x77\xf0\xfb\xef\x95\xcf\x36\x98\xbf\xb5\xaf\x58\x3e\xcb\x18\xdf\xbc\x86\xc7\xe9\xc0\xec\x24\xd7\xd2\xd1\xc8\x33\x21\x66\x9d\x90\x36\x5e\x52\xdb\xea\xb5\xb7\xd4\x72\x6d\xaf\x6c\x5a\x1e\x53\xcf\xc6\x99\xe8\xba\x65\x84\xd5\xec\x08\x20\x23\x29\xdc\xdb\xa6\x96\x66\x35\x5d\x2e\x8a\x8a\x0d\xeb\xa6\x03\x0b\x3d\x42\xb5\xaa\x78\x27\xe2\x77\x9b\xba\xfe\x4e\xc9\x13\x1d\x40\x31\x3c\xa4\x5c\x29\x7a\x21\x1c\xb2\x4f\x0f\xef\x97\x65\xa1\x1f\x58\x20\x5d\xaa\x7c\x2c\x2e\xc7\x88\x15\x52\x24\xf9\x4f\xf0\x01\x09\xd3\xe6\x4e\x31\xa1\xed\x28\xc4\x5e\x6a\xaf\xd7\x10\xfa\xd3\x41\xb3\x03\xec\xc8\x43\xd1\x77\xd2\xa6\x98\x0b\xbf\x79\xc4\xc0\x8b\x0d\xa2\x63\xb8\x10\x6b\x12\x47\x61\x61\xf8\xa9\xe7\x8e\xe7\x7a\x39\x06\xbb\x3c\xb5\x67\x79\x16\xd3\xa5\x53\xe8\x2e\xcc\x07\xe1\x07\xa6\x40\xe2\x54\x21\xc3\x72\xf9\x01\x05\x1d\xba\x3a\xf3\xb0\x0d\x79\x7f\x3a\x68\xd6\x98\x86\x6d\x59\xd0\x6e\xb9\xf5\xd9\x79\x64\xda\xbe\x94\xdd\x48\x35\x30\x2d\x5c\x98\xff\xfe\x73\x47\x9d\xbe\x83\x40\x70\x70\x4c\x8f\x54\xf1\x06\x99\xae\xa9\xc5\x4a\xb2\x8a\xef\xe7\x85\xe7\xa2\xcd\xf9\x75\x08\xe7\xdc\x52\x03\x73\x1f\xf7\xc8\x4d\x1d\xdc\x39\x48\x61\x1d\xe1\x9d\x22\xbe\xd3\xf7\x44\x4d\xa3\xc8\xdb\x53\xd6\x4e\x95\xd6\xaa\x33\x46\xd6\xbb\x7d\xd6\xdc\x04\xa9\xed\x81\x9c\xa7\x09\xd2\xbf\x65\x1d\x7a\x99\xd6\x6a\x7e\x6d\xb5\x96\xb9\xa9\x6e\x2d\xea\xf4\x23\x0b\x2b\xf5\x4b\xed\x95\xe3\x56\xe9\x0b\xaf\xcf\x22\xb2\x5a\xef\xeb\xd3\xa4\x67\xc7\x2d\xcd\xee\x45\xd9\x0a\xc6\xc1\x47\xa7\x79\xe5\xcd\x85\x36\x52\x91\x5f\x0d\x5f\x4a\x42\xa4\x63\xdf\x9e\xca\x87\x6c\x00\xd0\xce\x8a\x6c\xd0\x43\x27\x6e\xe4\xc4\x8d\x9c\xb8\x91\x13\x37\x72\xe2\x46\x9e\xc0\x8d\x8c\xec\x5f\x90\x50\x6a\xfe\xd2\x04\x49\xc3\xd4\x16\x4d\x85\x22\x79\x30\xe0\x37\xc5\x93\x6c\x4a\xdf\xda\xf7\xc4\x6e\x98\xd8\x0d\x13\xbb\x61\x62\x37\x4c\xec\x86\x89\xdd\x30\xb1\x1b\xbe\x32\xbb\xe1\xeb\x90\x25\x9b\x71\x42\x3b\x63\x52\xe0\x44\x97\x9c\xe8\x92\x13\x5d\x72\xa2\x4b\x4e\x74\xc9\x89\x2e\x39\xd1\x25\xbf\x75\xba\xe4\xbf\x9d\x7f\xd8\x08\x33\xfe\x83\x48\x88\x4d\xcd\x5e\x9b\x89\xd8\x1c\x6f\xa2\x23\xbe\x32\x1d\xb1\x01\xf8\xc4\x49\xb4\x9c\xc4\xa3\x51\x99\x88\x89\x13\x31\x71\x22\x26\xbe\x3a\x31\xb1\x3b\x02\x5d\x04\xcc\x8f\x09\x14\x02\x2d\x70\x35\xeb\x55\xa9\xe7\x15\x7c\xc3\x51\x90\xdb\x6b\x61\x29\x86\x9d\xf6\xc8\xed\xa1\x7c\xea\x3a\x02\xf7\x82\x6d\x38\x46\x4c\x77\x97\xf5\x6f\x23\x55\x16\xec\x9e\x5e\x56\xe5\xe0\xd1\xa6\x5d\xdf\x06\x33\xa0\xf6\xf2\xbf\xa9\xf7\x91\x0c\xcb\xe1\x29\x1a\xc5\xb4\x3c\x90\xdd\x47\x3d\x95\x89\xea\x62\x5b\xb6\x4e\xdc\x0b\x30\x2f\x07\x57\xda\x18\xfa\xc3\xb1\x2c\xcc\x56\x65\x5e\x9c\x91\x39\x5a\xb5\x82\x1a\x38\x5a\xbf\x5e\x76\x66\xfb\x54\x15\x83\x7c\x45\xa6\xe6\xf1\x88\xf4\x32\x36\x5f\x85\xb5\xd9\x8e\xde\xab\x30\x38\x47\xa6\x50\x46\x21\x36\x26\x0d\x31\x2e\x71\x32\x92\xd5\xd9\x8e\xd3\x6b\x30\x3c\xc7\x04\x4b\xbe\x96\x1f\x76\xb4\x7a\x27\xb2\x3d\x9b\xba\x7f\x2d\xca\xe7\x68\xeb\x30\x72\x3c\x24\x2f\x40\xff\x1c\x29\xd7\x90\x35\x76\xd0\xa6\x06\x62\xa9\x31\xab\x61\x04\x2d\xf4\xc5\xa9\xa1\xc1\xd6\xc6\x70\x43\x47\x00\xd8\xf7\x30\xb9\xff\x59\x72\x10\xb6\x9e\x7b\xe9\x26\x4e\x36\xad\xbd\xe7\xa5\xfe\xf8\xa8\xe9\xf9\x64\xca\x23\xc4\x1a\x17\x3c\x1d\x15\x61\x1c\x4b\xb2\xbc\x4e\x98\x98\xc3\x75\x41\x96\x9c\xc3\x35\x91\x10\xe7\x96\x3c\xf1\x81\xa8\x1e\xc1\x77\xbe\x16\xf1\x72\x84\x4d\x3d\xdf\x59\xf4\x45\x59\xde\xc2\x8e\x25\x64\xbe\x98\x33\xe9\x20\x67\xbe\xa6\xa7\xe9\x69\x1c\xc8\xd5\xab\x59\x2f\xc2\xc7\x70\xbc\x0f\x00\xff\xea\x44\xef\x6f\x85\xa9\xda\x40\xee\x35\xe9\xaa\xee\x01\xe4\x00\x24\x77\xe1\x95\x64\x75\x6d\x35\xa7\xb7\xf3\x2f\x53\xec\x77\x76\x36\x45\xde\x56\xd0\x90\xe1\x07\xaa\xd7\x10\x81\xf8\x65\xb6\x7d\xc7\x1a\x9f\x9d\xb0\x78\x0f\x9f\x87\xb7\x8a\x63\x5f\x89\xd7\xa5\xa1\x96\x2f\x28\xc8\x73\x53\x4b\x15\xf2\xdd\x8b\x49\xd5\xed\xd5\x16\xee\xae\xa3\xe5\x3b\xc1\xd2\xf2\xd9\x8b\x76\x8c\x8f\x0a\x71\xe9\x6a\xd6\x0b\x47\x4b\x14\xdd\xa2\x7c\x99\xb2\xae\x45\x49\x72\xd3\x17\x65\xea\xb9\xbd\xd7\x2a\xe9\x1a\x76\x49\x92\x86\x36\x42\xa6\x03\x85\xf5\x0e\x6e\xc1\x1c\xa3\xdb\x33\x5e\x59\xbf\x3c\xe3\xbc\x01\xd5\xb7\x45\x3b\x6f\x08\x3f\x3b\x3e\xfa\xf2\x54\xaf\xf6\xc2\x41\x56\xe6\x7f\x1e\xf7\xbc\x81\xe8\x1f\x86\x80\xde\x3e\xd2\xa2\x76\x41\xda\x28\x32\x87\x0b\xbf\x63\xe8\xb6\x03\x53\x1f\xe9\xe5\x15\x68\xec\x25\x5f\x6f\x35\xeb\x35\x94\x82\xe9\xdd\x74\x1b\x13\x0b\x7d\x62\xa1\x4f\x2c\xf4\x89\x85\xfe\xc7\x62\xa1\xfb\xfd\xee\xe2\xe6\xf2\xc6\xdd\xb1\xc7\x73\xb8\xf9\xdb\xf9\xc5\x0d\xb2\x78\x3f\xaf\x90\x04\x2f\x6d\x22\x04\xe3\xea\xb7\xf3\x90\x45\x25\xcd\xc2\x2e\x12\xfa\x39\x4d\xc5\xfe\x1d\xf1\xd0\x7f\xb5\x56\xf3\xab\x76\xe2\xb7\x7f\x53\xfc\x76\xf7\x8f\x08\x97\xeb\x91\x45\x11\x66\x06\xe3\xca\x45\x15\x9d\x4e\x56\x70\x76\x56\xfb\x27\x8c\xed\xaf\xa5\x3e\x2b\xf8\x95\xfe\x22\x57\xea\xb6\xf2\x9c\x08\x7e\xfd\xc7\xec\x5f\x03\x00\x3b\xae\x90\x53\xd6\x7a\x00\x00")
 
 func configCrdsKudobridgeDev_bridgeinstancesYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -97,6 +98,26 @@ func configCrdsKudobridgeDev_bridgeinstancesYaml() (*asset, error) {
 	return a, nil
 }
 
+var _configCrdsKudobridgeDev_clusterbridgeinstancesYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x5b\x5f\x6f\x1b\x37\x12\x7f\xd7\xa7\x18\xf8\x1e\xfc\x22\xad\xe3\xa6\x38\x1c\x84\x6b\x01\x9f\x9d\x16\x46\x12\xd7\xb0\xdd\xdc\x43\xd1\x07\x6a\x77\xa4\xe5\x99\x4b\xee\x91\x5c\x39\x6a\xe2\xef\x7e\x18\x92\xfb\xff\x8f\x24\x27\x79\xba\xd4\x46\x63\x2d\x67\xc9\xdf\xfc\x38\x9c\x19\x0e\xa9\xc5\x62\x31\x63\x39\xff\x80\xda\x70\x25\x97\xc0\x72\x8e\x1f\x2d\x4a\xfa\x64\xa2\xc7\x7f\x98\x88\xab\xb3\xed\xf9\x0a\x2d\x3b\x9f\x3d\x72\x99\x2c\xe1\xb2\x30\x56\x65\x77\x68\x54\xa1\x63\xbc\xc2\x35\x97\xdc\x72\x25\x67\x19\x5a\x96\x30\xcb\x96\x33\x00\x26\xa5\xb2\x8c\x1e\x1b\xfa\x08\x10\x2b\x69\xb5\x12\x02\xf5\x62\x83\x32\x7a\x2c\x56\xb8\x2a\xb8\x48\x50\xbb\x11\xca\xf1\xb7\xaf\xa2\xd7\xd1\xab\x19\x40\xac\xd1\xbd\xfe\xc0\x33\x34\x96\x65\xf9\x12\x64\x21\xc4\x0c\x40\xb2\x0c\x97\x10\x8b\xc2\x58\xd4\x2b\xcd\x93\x0d\x72\x69\x2c\x93\x31\x9a\xe8\xb1\x48\x94\x7f\x16\x25\xb8\x9d\x99\x1c\x63\x1a\x7f\xa3\x55\x91\x2f\xa1\xd3\xea\xfb\x0a\x00\x83\x72\xbe\xdb\x7f\xb9\x2e\xae\x43\xb7\xae\x5d\x70\x63\xdf\x8e\xcb\xbc\xe3\xc6\x3a\xb9\x5c\x14\x9a\x89\x31\x80\x4e\xc4\x70\xb9\x29\x04\xd3\x23\x42\x33\x80\x5c\xa3\x41\xbd\xc5\xdf\xe5\xa3\x54\x4f\xf2\x17\x8e\x22\x31\x4b\x58\x33\x61\x70\x06\x60\x62\x95\x63\x05\x84\x1e\x14\x2b\x1d\x26\x24\xa8\x63\x2c\xb3\x85\x59\xc2\xa7\xe7\x19\xc0\x96\x09\x9e\x38\x3a\x7d\xa3\xca\x51\x5e\xdc\x5e\x7f\x78\x7d\x1f\xa7\x98\xb9\x09\xa3\xc7\x09\x9a\x58\xf3\xdc\xc9\x0d\x6b\x09\x9e\x3d\x03\xac\x84\xee\xb1\x24\x70\x79\x77\x05\x56\xc1\xdb\xdf\xaf\x7e\x9b\x83\x4d\xd1\xfd\x05\xe5\x7b\x06\x98\x46\x3f\xa7\x98\x00\x97\x4e\xe2\x81\xe9\x0d\xda\x1b\x96\xa1\xc9\x59\x8c\x51\x40\x91\x6b\x95\xa3\xb6\xbc\x9c\x18\xfa\x69\x98\x68\xf5\xac\x83\xf7\x94\x14\xf2\x32\x90\x90\x51\xa2\x71\xa3\x6c\xfd\x33\x4c\xc0\x38\x65\x41\xad\xc1\xa6\xdc\x80\x46\xc7\xb2\xf4\x66\x4a\x8f\x99\x04\xb5\xfa\x0f\xc6\x36\x82\x7b\x62\x5f\x1b\x30\xa9\x2a\x44\x42\xd6\xbb\x45\x6d\x41\x63\xac\x36\x92\xff\x55\xf5\x66\x48\x69\x1a\x46\x30\x8b\xc6\x02\x97\x16\xb5\x64\x82\x28\x2f\x70\x0e\x4c\x26\x90\xb1\x1d\x68\xa4\x7e\xa1\x90\x8d\x1e\x9c\x88\x89\xe0\xbd\xd2\x08\x5c\xae\xd5\x12\x52\x6b\x73\xb3\x3c\x3b\xdb\x70\x5b\x2e\xbe\x58\x65\x59\x21\xb9\xdd\x9d\xb9\x25\xc4\x57\x85\x55\xda\x9c\x25\xb8\x45\x71\x66\xf8\x66\xc1\x74\x9c\x72\x8b\xb1\x2d\x34\x9e\xb1\x9c\x2f\x1c\x58\x49\x4a\x99\x28\x4b\xfe\x56\x19\xc6\x69\x83\x3a\xbb\x23\x03\x32\x56\x73\xb9\xa9\x1e\xbb\x25\x30\xca\x2f\x19\x3f\x70\x9a\x7a\xff\x9a\x57\xb1\xa6\x91\xcb\x8d\x23\xfc\xee\xcd\xfd\x03\x94\x83\x7a\xaa\x3d\xab\xb5\xa8\xa9\x09\x26\x72\xb8\x5c\xa3\xf6\x92\x6b\xad\x32\xd7\x0b\xca\x24\x57\x5c\x5a\xf7\x21\x16\x1c\xa5\x25\x2b\xcf\xb8\xa5\x99\xfb\x6f\x81\xc6\x12\xf7\x11\x5c\x3a\x57\x03\x2b\x84\x22\x4f\xc8\xbc\x22\xb8\x96\x70\xc9\x32\x14\x97\xcc\xe0\x37\xa7\x97\x98\x34\x0b\xa2\x6e\x3f\xc1\x4d\x0f\x59\xfe\xe7\x05\x3d\x43\xd5\xe3\xd2\x6f\x0d\xce\xc4\xe0\xca\xbc\xcf\x31\x6e\xd9\x7d\x82\x86\x6b\xb2\x53\xcb\x2c\x3a\xeb\x1e\x5e\xd2\x8d\x51\x86\x56\x5e\xdb\x77\xb7\x9f\x77\x71\x55\x62\x0e\x3f\x5f\x73\x24\x6b\xc9\x55\x02\x16\xb3\x9c\x56\x08\x64\xa8\x37\xce\x03\x84\x65\x43\x6e\xa3\xee\xde\xc9\x3e\x71\x9b\x82\xb1\x9a\x59\xdc\xf0\xd8\xbf\x01\x06\x33\x26\x2d\x8f\xcd\x1c\x30\xda\x44\xb4\xea\x0c\x7a\xe3\xe0\x19\xdb\xe0\xbc\xb2\x38\x33\x07\xa9\x12\xbc\x47\x81\xb1\x55\x1a\x94\x06\x83\x71\xa1\xb9\xdd\x5d\x2a\x69\xf1\xa3\x25\x32\xe8\xc5\x58\x27\x8b\xc6\xd8\xf4\x27\xe3\x12\x75\x47\xc7\xc1\x09\xf2\xbf\x1f\x17\x14\xc7\xb4\x44\x8b\x66\x51\xfa\xec\x45\xe1\x9d\xf6\x62\x1d\xbc\xb6\xd5\x45\x93\x65\x8a\x6d\x25\xba\x69\x3e\xef\x2a\x25\x6a\x3e\x4b\xd2\x3c\x1e\x03\xb9\x56\x31\x1a\x83\x09\xac\x76\x8e\x0e\xef\xa1\xe7\xc0\x84\x08\x8a\x66\xf0\x94\xa2\x04\x5a\x25\x06\x9d\x6f\xdb\xa2\x66\xa2\xf2\xe5\x81\x0e\xc3\x32\x04\xb2\x63\x30\xb9\xe0\xb6\x37\x92\x9b\x17\x9b\x22\x27\x42\x3d\x2e\xe3\xfc\x5b\xae\xb9\xd2\x9c\xcc\x26\x9a\xb5\xb4\x19\x35\x28\xfa\x15\x6c\x85\x62\x8c\x87\x1e\x17\xef\x9a\xd2\x0d\xf3\x22\x90\xae\xa7\x4a\x8d\x06\xe4\x81\x4e\xa7\x10\xd1\x4f\xc6\x6c\x9c\xbe\xf9\x48\x73\x69\xea\xe4\x65\x0f\xb8\xee\x4b\xde\x4b\x52\xc2\x40\xa0\x1c\xbc\x8a\x32\xe7\xbc\xb8\xc6\xcc\xbb\xc1\x87\x14\x5b\x4f\x5c\x9c\xbc\xb8\xb9\xc2\x24\x9a\x0d\x0e\x0c\xdc\x62\x36\x0a\xab\x03\xec\x62\x62\xf0\xe0\xca\xcb\x16\x9b\x32\x5b\x2e\x01\xe3\x5d\xbb\x99\x03\x83\x47\xdc\xf9\x28\x46\xc1\x31\x47\xcd\x2a\x61\x8d\xb4\xa2\xbd\x45\x3e\xe2\xce\x09\x85\x90\x36\x8a\x6e\x1f\xfd\x21\x0e\xe1\x6e\xaa\xb9\xa3\x24\x8d\xcd\x1b\x96\x40\x90\x3d\x42\x7a\x54\xa9\xce\xf2\x5c\xb8\x05\xa4\xc6\x98\x9d\x70\xdc\xfd\x9f\x92\x8b\x23\x80\x56\xf4\xd5\x71\xd0\x13\x7c\x4a\x21\x4d\xb8\x34\xc4\xa4\x3c\x27\xe7\x46\x33\xe3\x1c\x55\x99\x24\x7c\xa0\x14\xae\x1a\xd5\xe7\x53\xd7\x72\x0e\x37\xca\xd2\x3f\x6f\x3e\x72\x0a\x88\x34\x07\x57\x0a\xcd\x8d\xb2\xee\xc9\x57\x51\xd5\x43\x38\x42\x51\xff\x82\x33\x30\x09\x4c\x6b\xb6\x23\x4d\x9a\x59\x83\x89\xe0\xda\x7b\x9d\x8a\x14\x6e\x28\x6e\x2b\x5d\x6a\x44\x8d\xa1\x23\xdf\x45\x56\x18\x17\xe6\xa5\x92\x0b\xcc\x72\xbb\x1b\xec\x23\x10\xa1\x74\x8b\x87\x89\xee\x42\x57\x0f\x94\x7d\xf8\x81\x7c\x6e\x28\x58\x8c\x09\x24\x85\x03\xcd\x7a\x01\x29\xa7\x35\x3f\x4d\xef\x9e\x95\x7a\xd4\x1c\x94\x82\x0e\xe1\xa8\x5c\x58\xde\xad\x24\xae\xfd\xb3\x20\x7b\x9b\x68\x2d\xa9\x1c\x15\x99\x88\x86\x87\x22\x75\xee\xd2\x39\xf4\x51\x76\x58\x92\xb8\x3d\x25\x13\xb7\x07\x78\x8c\x03\x38\x6c\xd9\x67\x03\x80\x33\x52\xc8\x58\x4e\x16\xfa\x89\x9c\x9d\x33\x92\x67\xc8\x19\xd7\x26\x82\x0b\xb7\x59\x13\xd8\x6a\x0b\xfb\x97\x66\x37\xd4\x03\x37\x40\xfc\x6f\x99\x20\xf7\x4a\x8b\x58\x02\x0a\xef\x6c\xd5\xba\x17\x5a\xe6\xf0\x94\x2a\xe3\x7d\xa7\x4b\x15\x08\xcb\xc9\x23\xee\x4e\xe6\x3d\xab\x3e\xb9\x96\x27\xde\x0d\xf7\xec\xb8\xf2\xd9\x4a\x8a\x1d\x9c\xb8\xb6\x93\x97\x04\x96\x3d\x33\x3b\xd9\x2c\xcb\x4d\xdc\xc1\x01\xfd\xe4\xa6\xfb\xca\x64\x54\xaf\x06\x18\x08\xf3\xf3\xb0\x47\x68\xed\x47\x43\x1b\x24\x4a\x9e\x5a\xcf\x3d\x70\x7b\x32\x3b\x3e\x26\x75\xe7\x6d\x79\xb0\x89\x7d\x4f\x09\xbe\xa7\x04\xdf\x53\x82\xef\x29\xc1\xf7\x94\xe0\x7b\x4a\xf0\x7f\x96\x12\x8c\x36\xc5\x3a\xb9\xef\x14\xb7\x7a\x93\x71\x79\x77\x45\x32\x9d\x84\x20\x54\x98\x9f\x88\xb3\x43\x47\x7b\x71\x89\x26\x41\x81\xe4\x7c\x6f\x95\xe0\xf1\x6e\x12\xed\x55\x4b\xb4\x01\xfa\x89\xe2\x67\xca\xf2\x1c\x65\x55\x26\x6e\x55\xc5\x7d\x5d\x86\x2a\x9a\x75\x36\x43\x33\xee\x06\xc7\x64\x4e\xf5\x3c\x56\x08\x57\xe8\xf4\xc3\xe0\xa0\xe2\x03\x66\x5e\x9e\x25\x50\x96\x35\x89\xbe\xc4\x42\x82\x0d\xec\x0c\x7e\x55\x75\xd5\x4e\xad\x07\xc0\x53\x4a\x06\x48\xf6\x4f\x55\x57\x60\x1b\x32\xb9\x6e\xd9\xc8\x15\x94\xe6\xee\x9c\xc5\xff\xdf\x25\x71\x73\x7f\x20\xe3\xac\x37\xd4\xe7\x43\x5d\xef\xd3\x27\x88\x5c\xa1\xf9\x33\x08\xf5\x84\x1a\x9e\x9f\x17\xf4\xcc\xc1\x7b\x7e\x8e\xe0\xaa\x41\x49\x67\x28\xea\xde\xa7\x4f\x8e\xe5\x0a\xfd\xba\x90\x31\x4d\x95\x37\x78\xb6\x65\x5c\xb0\x95\xc0\xa8\x43\xcb\x28\x97\x74\x6a\xf4\x5b\x58\x79\x93\x5c\xd2\xb0\xa5\x60\x83\xcb\x8a\xba\xb2\x6d\x76\x78\x06\xc4\x04\xd1\x90\x5c\xe4\x79\x38\xdb\x18\xf4\x79\x2d\x14\x17\xbd\x57\x3a\x58\x0c\x66\x5b\xd4\xa0\x99\xdc\x54\x53\xeb\x52\xa1\xd8\x95\x20\xca\x19\xe9\xd7\x1a\x63\x26\x21\xe7\xb2\xaa\x04\x76\xce\xd3\xce\x58\x9e\x2f\xc2\xcb\x8d\x03\x40\x3f\x25\xf5\x67\xb2\x70\x7f\x1c\x82\x49\xbb\x32\x39\xa0\xd9\xe8\xa4\x34\xc8\x39\x96\x99\x23\x68\x79\x31\x15\xa5\xa7\x1e\xe0\x23\x58\xfa\xcf\x3f\xbd\x8a\xce\xa3\x57\x73\xf8\xa7\x3b\xf2\xfc\x86\x2c\x55\x86\xb0\x9f\xa0\x4a\x74\xca\x7c\xe1\xa2\x61\x2c\x41\xfe\x58\x50\x5c\x86\xc3\x88\xb2\xd3\xbd\xd8\xae\xbb\x6f\x10\x43\x05\x15\xbe\xad\x72\xc5\x7f\xb1\xa5\x53\xb4\x72\x1b\x58\x4e\xc0\x40\xb7\xde\xa6\x56\x4a\x09\x64\xb2\xd7\x9e\xb3\xf8\x91\x6d\x70\x2f\x9e\x5b\x2f\x37\x44\x54\xe8\xc2\xf9\xbb\x63\x89\xd1\x98\x2b\xc3\xad\xd2\xbb\xbd\x08\xc8\xa7\xdc\x55\xe2\x43\x40\x1a\xad\xbf\xdf\xbd\x3b\x16\x4a\x30\xdd\xbd\x38\x0e\x31\x99\x17\x99\xc9\x68\x58\xcf\x99\x66\x19\x5a\xd4\xef\x59\x9e\x73\xb9\xe9\x2d\xfd\x16\xbe\xdb\xae\x74\x03\x69\xaa\x9e\xaa\xb5\xed\x93\x81\xb9\x37\xa2\xa4\x3c\xce\x2c\xcf\xec\xe6\x2e\x7a\x64\x14\xce\x93\x56\x34\xaf\x74\xac\x50\x99\x08\xfe\x4d\x2b\x36\x6c\xe9\xe6\x21\x35\x0c\x83\x53\x37\xce\x6e\xcb\xdc\xcd\xed\x2b\x52\x25\x12\x3a\x0f\xe5\xb2\x4a\x7f\x48\x90\x6f\xa4\xd2\xfd\x04\x6d\x64\x1f\x31\xa9\x36\x55\xb9\x28\xb3\x75\x6a\x96\x0e\xce\x65\x53\xf5\xf1\x3d\x1b\x53\x69\x76\xdc\xb6\x3d\x24\x2e\x43\x4d\x1d\x94\x21\x9e\x77\x8c\x87\xb2\x8a\xc0\x92\x8b\x10\xad\x29\xa2\x85\x1f\x9c\x21\x9d\xee\x51\x5b\x15\xeb\x35\x4a\x47\xa3\x54\x3e\x25\x1e\x04\x30\x69\xf5\xe0\xce\xa0\x0f\x40\xfe\x8b\x56\x59\x07\x76\xce\x6c\x5a\x32\x5b\xc1\x0d\xfe\x3e\x22\xd1\xc8\xf0\xbf\x90\x40\x47\xa5\x59\x45\xb5\xcb\x37\x7f\x9c\x50\x14\x71\xf1\x63\xad\xd4\xc9\x9f\x2f\x01\xaf\x71\x8d\x1a\x65\x8c\x07\x68\x70\x57\xca\xb6\xd4\x60\xb6\x8d\xbf\xee\x32\xd4\x26\x48\x43\x06\xf7\x18\x6b\xb4\xa4\xcb\xa5\x92\x6b\xbe\x79\xcf\xf2\x39\x08\xfe\x48\x47\x2e\xd4\xf2\x16\x77\x77\xb8\xf6\xb6\x5f\xf5\x10\xca\x41\x34\x83\x39\x33\xc1\x79\x93\xc4\xb8\xa1\x1d\xa3\xf3\x4d\x7d\x9d\xe7\x30\xc5\xdd\x0b\x9d\x49\x24\xaf\x5d\xd5\x1d\xbd\x96\xa6\xa5\x66\x9d\x0d\xb8\xf9\x75\xb9\x40\x05\x61\x3e\x74\xac\xcd\xc3\x56\x2c\x24\x2c\xa4\xb4\x46\xe6\x56\x7f\x36\x3b\xba\x4e\xb0\x87\x8d\x7d\xbb\xed\xe9\x8a\x40\x87\x27\x2f\xda\x60\x88\x77\xac\xbb\xb7\x02\xfd\x04\x97\xa5\x14\x5a\xa3\x21\x7b\x29\x77\x33\x6e\xf2\xb5\xda\xf2\x04\x93\x41\x08\xd3\xf1\x19\xaa\xa1\x0e\x50\xe0\xa1\x44\x35\xb6\xb3\xd9\xbb\x7f\xa1\x3d\x0d\x4d\x95\x5a\xbb\x05\x1f\x16\x33\x6d\x49\xa8\xcb\x28\xc3\x8c\x62\xef\x67\xc8\x0a\x01\xe7\xaf\x7e\xf8\x11\x9e\x9f\xdf\xf2\x2f\xd8\x85\x1c\x34\xc5\x56\x1d\xa2\xbb\x9a\x8c\xc8\xd5\x8a\x1b\x4b\x53\xf6\xa0\x18\x37\xa3\x05\x58\xd5\x7b\x38\xb1\x45\x1f\x33\xd7\x46\x5c\xbc\xdf\x49\xcb\x3e\x2e\x67\x13\xea\xde\x76\xa5\x07\xa2\x7c\x2b\xd2\xd2\x34\x3c\x69\x6e\x2d\xca\x46\xd4\x6d\x6f\xba\xef\xf9\x86\x8b\xd9\x81\xac\x84\x7b\x10\xd3\xd5\x82\xdb\x20\xd4\x40\xf7\x94\xf2\x38\x0d\xb7\x31\xaa\xfb\x1c\x64\xa8\x0d\x33\xf4\x45\x79\x7f\xcb\xc3\xb4\xef\x6f\x78\x8f\x93\xf2\x4d\x4a\x17\xd0\x4a\x10\xf0\xc4\xa5\xe9\x00\x59\x2b\x9d\x31\xbb\xa4\xdb\x37\xaf\x7f\xe8\xb4\x79\xad\xe8\xfa\xda\xa6\xe3\x84\xfd\x4d\xc2\x32\x77\x9a\x54\xee\xbe\x25\x3a\x30\x01\xed\x0a\x82\xef\x39\x14\x57\x95\x6e\x5c\x0d\x24\xcd\x7d\xeb\xec\x20\xc7\x38\x8e\xa2\xcc\x7a\x68\x9d\x8f\x14\x32\xa8\x2c\x57\x3a\xb3\xf5\xf4\xf0\xfb\xf2\x9e\x03\x53\x87\xd3\x81\xdc\xa1\x8d\x29\x5c\x1f\x44\x6e\x53\xd4\xc0\x82\x4e\xf7\xee\x86\x53\xe9\x72\xcb\x6c\x83\xc9\xfa\xbd\x76\xc6\xe1\x5e\x8a\x72\xc1\xa4\x7f\x3f\x4a\x30\x17\x6a\x17\x1a\x4e\x67\x3d\x94\x53\xe6\xfd\x25\xae\xa7\x9b\x18\x85\x99\x1f\x44\x9b\x32\xf3\x95\xdd\x11\xcd\xca\x37\xf3\x52\xa6\x30\x39\xb6\xef\x4d\xf6\xf8\xb8\xf7\x32\x60\xac\xca\xcd\x50\x82\x40\x08\x5d\xa8\x61\x42\x50\xaa\xae\xb4\xbf\xcd\x58\x6e\x42\x5a\xc6\x11\x56\x3c\xf5\x48\xed\xb5\x23\x8f\x53\x2a\xe9\xf8\x00\x33\xb4\xa2\xca\xe2\x45\x60\x9f\xf6\x1f\xae\xee\x43\xc7\xa5\x64\x3c\xf5\xf5\x31\xbf\x26\x4d\x91\xf5\x42\xf4\x78\x70\xb6\xed\xeb\xbc\x93\x84\x74\xae\xfe\x76\xac\xa5\x77\xd8\xdb\x56\xdf\x95\x0d\xd5\x7a\x80\xc7\xd9\x81\x06\x53\xdf\x87\x36\x93\x30\x3f\xd4\x72\x1d\x88\x97\x6f\xde\x81\x2e\x04\x9a\x1e\xb9\x2e\xf3\x31\xcc\x72\xb3\xde\xc1\x0a\xd7\x74\xb9\xd7\xdf\x9a\xab\x26\xca\xcf\x50\x9d\x00\x93\x7a\x2f\xf0\x73\x35\xba\xbb\x42\x60\x75\xf9\x93\x39\x70\x58\x9d\x2a\xec\xcd\x71\x8e\xf4\x71\x19\x1a\x33\x52\x20\xe9\x00\x7c\xef\x25\x3b\xd4\x85\xf7\x6b\x0b\xad\x76\x78\x44\x68\xb5\xb9\x73\x0c\xf2\x6e\x15\xbc\x14\x3b\xde\x41\x80\x9b\xaf\x03\x50\x9f\x3a\x36\xfb\xd3\x5d\x33\x3a\xef\x25\x89\x06\x56\xaa\xa0\xed\x3c\x5d\x4b\x15\xeb\xe0\xd2\xe8\xcf\xc6\xb6\xef\xe7\x9f\xe0\x35\x7c\xfe\xdc\x78\xec\xf6\x03\xf7\xee\x82\xc9\x8d\x4a\xf0\xf4\xeb\xba\xbd\x41\x9e\x8e\xf6\x70\x43\x23\x2c\xba\x8b\x7d\xb6\x67\x80\xf0\x6d\x84\xd9\x08\xe5\x9d\xab\xcc\x4e\xb8\x75\x99\x59\xad\xdc\xb9\x4d\xe3\x36\x73\x29\x3c\xdb\x6f\xb6\xab\x81\xde\x97\x83\xba\x0f\x90\x1c\x2b\xe9\xaf\x22\xf5\x5e\x69\x69\x70\x59\x89\x75\xac\x9d\xf6\x44\x74\x0f\xd4\x29\x10\x5c\x49\xf0\x5d\x6d\xad\xbd\x6a\x2f\x70\x03\xd5\xd0\x90\x71\xad\xe9\x52\x6e\x59\xb6\xda\x9e\x47\x75\x63\x18\x54\x22\x9d\xaa\xbc\xad\xce\xc4\xe0\xe2\xf6\xba\xaa\xf8\x1f\xe9\x09\x04\x33\xf6\x41\x33\x69\xdc\x08\xf4\x4d\x9d\x21\xa9\x0e\xdc\x77\xbd\x97\x7a\x8c\x91\x87\xa2\xe7\xa4\x47\xc5\x7f\x88\x6e\x09\xf0\x2a\x82\x0d\x0e\x56\x26\xb9\xf4\x5d\x80\x85\xe5\x2f\xd9\xda\x7c\x99\x8f\x63\x90\x16\x99\x2b\x09\xb0\x84\xce\x99\xca\xce\xca\x19\x20\xd6\xc0\x56\x0c\xbc\x04\x5e\xb9\x1c\x7e\x45\x49\xbb\xb9\x91\x22\x6d\x07\xe9\x6f\xbd\x97\x3a\xc4\x6f\xea\x86\x61\x0b\x6d\xcf\xc7\x13\x33\xee\x92\xea\x5a\xe9\xc9\x89\xe0\xd2\xfe\xfd\xc7\x41\x89\xf1\x3d\x47\xe9\x7a\x98\x39\x48\xb5\x3b\x64\xa6\xa5\x0e\xab\xbf\x00\x12\x7a\xf9\x8a\xec\xf7\x9d\xd9\x08\x2c\xef\x6a\x3a\x2c\x87\xe4\x4b\xad\xdb\x74\xce\x41\x49\xe7\xd8\x1e\x34\x7d\x6f\xe8\x17\xfa\xa6\x17\x25\xfa\xe1\x1b\x60\x2f\xc1\xe9\xd4\xd8\x8f\xf2\x61\x97\x77\xc3\x1d\xbd\xd9\x43\x78\x3c\x84\xa9\xe0\xd4\xf7\x1d\x03\x42\x61\xe5\x0c\xb4\xf8\x49\x1d\x68\x18\xf1\x0c\x0b\x87\xf4\xcb\xa3\xe1\x21\x6b\xef\x2b\xaf\xba\x2a\x4f\x5a\xed\xda\x13\x62\x66\x87\x2f\xb8\xb1\xa5\x36\x40\x40\xe7\x51\x79\x0a\x04\xdb\x73\x26\xf2\x94\x9d\xd7\xcf\x5c\x50\x58\x84\x2f\x64\x36\x9a\x01\x3c\x45\x8d\x7b\x15\xc6\x2a\x4d\x0e\xd5\x3f\xa9\x57\x10\x8b\x63\xcc\x2d\x26\x8d\xca\x2d\xdd\x18\x58\xc2\xc9\x49\xeb\xdb\x94\xee\x63\xad\xf9\x12\xfe\xa0\xea\x38\xf5\xda\x38\xff\x85\x3f\xfe\x9c\xfd\x6f\x00\xd4\x26\xbd\xf1\xd1\x3a\x00\x00")
+
+func configCrdsKudobridgeDev_clusterbridgeinstancesYamlBytes() ([]byte, error) {
+	return bindataRead(
+		_configCrdsKudobridgeDev_clusterbridgeinstancesYaml,
+		"config/crds/kudobridge.dev_clusterbridgeinstances.yaml",
+	)
+}
+
+func configCrdsKudobridgeDev_clusterbridgeinstancesYaml() (*asset, error) {
+	bytes, err := configCrdsKudobridgeDev_clusterbridgeinstancesYamlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "config/crds/kudobridge.dev_clusterbridgeinstances.yaml", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
 // Asset loads and returns the asset for the given name.
 // It returns an error if the asset could not be found or
 // could not be loaded.
@@ -150,6 +171,7 @@ func AssetNames() []string {
 // _bindata is a table, holding each asset generator, mapped to its name.
 var _bindata = map[string]func() (*asset, error){
 	"config/crds/kudobridge.dev_bridgeinstances.yaml": configCrdsKudobridgeDev_bridgeinstancesYaml,
+	"config/crds/kudobridge.dev_clusterbridgeinstances.yaml": configCrdsKudobridgeDev_clusterbridgeinstancesYaml,
 }
 
 // AssetDir returns the file names below a certain
@@ -196,6 +218,7 @@ var _bintree = &bintree{nil, map[string]*bintree{
 	"config": &bintree{nil, map[string]*bintree{
 		"crds": &bintree{nil, map[string]*bintree{
 			"kudobridge.dev_bridgeinstances.yaml": &bintree{configCrdsKudobridgeDev_bridgeinstancesYaml, map[string]*bintree{}},
+			"kudobridge.dev_clusterbridgeinstances.yaml": &bintree{configCrdsKudobridgeDev_clusterbridgeinstancesYaml, map[string]*bintree{}},
 		}},
 	}},
 }}
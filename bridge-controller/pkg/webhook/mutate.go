@@ -0,0 +1,54 @@
+package webhook
+
+import (
+	"context"
+	"net/http"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+const (
+	// MutatePath is the path of the BridgeInstance mutating webhook
+	MutatePath = "/mutate-bridgeinstance"
+
+	// GroupLabel, VersionLabel and KindLabel select the BridgeInstance of a CRD object in the crd-controller
+	GroupLabel   = "group"
+	VersionLabel = "version"
+	KindLabel    = "kind"
+)
+
+// labeler sets the group, version and kind labels of the BridgeInstance from its CRDSpec
+type labeler struct{}
+
+func (l *labeler) Handle(ctx context.Context, req admission.Request) admission.Response {
+	// the object is patched as unstructured, a typed round trip would add or drop unrelated fields
+	bi := &unstructured.Unstructured{}
+	if err := bi.UnmarshalJSON(req.Object.Raw); err != nil {
+		return admission.Errored(http.StatusBadRequest, err)
+	}
+	crdSpec, _, err := unstructured.NestedMap(bi.Object, "spec", "crdSpec")
+	if err != nil {
+		return admission.Errored(http.StatusBadRequest, err)
+	}
+	gvk := (&unstructured.Unstructured{Object: crdSpec}).GroupVersionKind()
+	if gvk.Version == "" || gvk.Kind == "" {
+		// rejected by the validating webhook
+		return admission.Allowed("")
+	}
+
+	labels := bi.GetLabels()
+	if labels == nil {
+		labels = make(map[string]string)
+	}
+	labels[GroupLabel] = gvk.Group
+	labels[VersionLabel] = gvk.Version
+	labels[KindLabel] = gvk.Kind
+	bi.SetLabels(labels)
+
+	mutated, err := bi.MarshalJSON()
+	if err != nil {
+		return admission.Errored(http.StatusInternalServerError, err)
+	}
+	return admission.PatchResponseFromRaw(req.Object.Raw, mutated)
+}
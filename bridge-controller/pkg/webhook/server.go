@@ -0,0 +1,54 @@
+package webhook
+
+import (
+	log "github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/runtime/inject"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/conversion"
+
+	"github.com/zmalik/kudo-bridge/bridge-controller/pkg/apis"
+	"github.com/zmalik/kudo-bridge/bridge-controller/pkg/client"
+)
+
+// ConvertPath is the path of the BridgeInstance conversion webhook
+const ConvertPath = "/convert"
+
+// Server serves the conversion and admission webhooks of the BridgeInstance API over TLS
+type Server struct {
+	server *webhook.Server
+}
+
+// NewServer returns the webhook server, the certDir holds the tls.crt and tls.key serving certificates
+func NewServer(client *client.Client, port int, certDir string) (*Server, error) {
+	scheme := runtime.NewScheme()
+	if err := apis.AddToScheme(scheme); err != nil {
+		return nil, err
+	}
+	s := &Server{
+		server: &webhook.Server{
+			Port:    port,
+			CertDir: certDir,
+		},
+	}
+	if err := s.server.InjectFunc(func(i interface{}) error {
+		_, err := inject.SchemeInto(scheme, i)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+	// the versions are converted through the v1alpha1 storage version
+	s.server.Register(ConvertPath, &conversion.Webhook{})
+	s.server.Register(MutatePath, &admission.Webhook{Handler: &labeler{}})
+	s.server.Register(ValidatePath, &admission.Webhook{Handler: &validator{client: client}})
+	return s, nil
+}
+
+// Run serves the webhooks until the stop channel is closed
+func (s *Server) Run(stopCh <-chan struct{}) {
+	log.Infof("serving the webhooks on port %d", s.server.Port)
+	if err := s.server.Start(stopCh); err != nil {
+		log.Errorf("webhook server failed: %v", err)
+	}
+}
@@ -0,0 +1,376 @@
+package webhook
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Masterminds/semver"
+	kudov1beta1 "github.com/kudobuilder/kudo/pkg/apis/kudo/v1beta1"
+	"github.com/kudobuilder/kudo/pkg/engine/renderer"
+	"github.com/kudobuilder/kudo/pkg/kudoctl/packages/resolver"
+	"github.com/kudobuilder/kudo/pkg/kudoctl/util/repo"
+	"k8s.io/api/admission/v1beta1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/selection"
+	"k8s.io/client-go/discovery/cached/memory"
+	"k8s.io/client-go/restmapper"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"github.com/zmalik/kudo-bridge/bridge-controller/pkg/apis/kudobridge/v1alpha1"
+	"github.com/zmalik/kudo-bridge/bridge-controller/pkg/client"
+	"github.com/zmalik/kudo-bridge/bridge-controller/pkg/kudobridge/bridge"
+	"github.com/zmalik/kudo-bridge/crd-controller/pkg/params"
+)
+
+// ValidatePath is the path of the BridgeInstance validating webhook
+const ValidatePath = "/validate-bridgeinstance"
+
+// ovCacheTTL bounds the reuse of an OperatorVersion resolved from a repository, a newer version can be published
+const ovCacheTTL = 10 * time.Minute
+
+// validator rejects the BridgeInstances the crd-controller would fail to process
+type validator struct {
+	client  *client.Client
+	decoder *admission.Decoder
+	// resolved caches the OperatorVersions resolved from the repositories, the package is not downloaded on every admission
+	resolved ovCache
+}
+
+// ovCache holds the resolved OperatorVersions by repository, package, version and appVersion
+type ovCache struct {
+	mu      sync.Mutex
+	entries map[string]ovCacheEntry
+}
+
+type ovCacheEntry struct {
+	ov      *kudov1beta1.OperatorVersion
+	expires time.Time
+}
+
+// get returns the cached OperatorVersion, nil when it is missing or expired
+func (c *ovCache) get(key string) *kudov1beta1.OperatorVersion {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	e, ok := c.entries[key]
+	if !ok || time.Now().After(e.expires) {
+		return nil
+	}
+	return e.ov
+}
+
+// add caches the OperatorVersion and drops the expired ones
+func (c *ovCache) add(key string, ov *kudov1beta1.OperatorVersion) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	now := time.Now()
+	if c.entries == nil {
+		c.entries = make(map[string]ovCacheEntry)
+	}
+	for k, e := range c.entries {
+		if now.After(e.expires) {
+			delete(c.entries, k)
+		}
+	}
+	c.entries[key] = ovCacheEntry{ov: ov, expires: now.Add(ovCacheTTL)}
+}
+
+// InjectDecoder implements admission.DecoderInjector
+func (v *validator) InjectDecoder(d *admission.Decoder) error {
+	v.decoder = d
+	return nil
+}
+
+func (v *validator) Handle(ctx context.Context, req admission.Request) admission.Response {
+	bi, err := v.decode(req.Kind.Kind, req.Object)
+	if err != nil {
+		return admission.Errored(http.StatusBadRequest, err)
+	}
+	if !bi.DeletionTimestamp.IsZero() {
+		// the finalizer must be removable whatever the spec
+		return admission.Allowed("")
+	}
+	if req.Operation == v1beta1.Update {
+		old, err := v.decode(req.Kind.Kind, req.OldObject)
+		if err != nil {
+			return admission.Errored(http.StatusBadRequest, err)
+		}
+		if equality.Semantic.DeepEqual(old.Spec, bi.Spec) && old.GetNamespace() == bi.GetNamespace() {
+			// metadata updates like the finalizer don't need the operator to be resolved again
+			return admission.Allowed("")
+		}
+	}
+
+	violations := v.validateCRD(bi)
+	violations = append(violations, v.validateOperator(bi)...)
+	violations = append(violations, validateCRSelector(bi.Spec.CRSelector)...)
+	violations = append(violations, validateSources(bi.Spec.ParameterMappings)...)
+	violations = append(violations, validateReferences(bi.Spec.ParameterMappings)...)
+	if bi.Spec.InstanceName != "" {
+		if _, err := renderer.New().Template("instanceName").Parse(bi.Spec.InstanceName); err != nil {
+			violations = append(violations, fmt.Sprintf("instanceName is not a valid template: %v", err))
+		}
+	}
+	switch bi.Spec.DeletionPolicy {
+	case "", v1alpha1.DeletionPolicyDelete, v1alpha1.DeletionPolicyOrphan, v1alpha1.DeletionPolicyRetain:
+	default:
+		violations = append(violations, fmt.Sprintf("deletionPolicy must be one of %s, %s or %s",
+			v1alpha1.DeletionPolicyDelete, v1alpha1.DeletionPolicyOrphan, v1alpha1.DeletionPolicyRetain))
+	}
+	if len(violations) > 0 {
+		return admission.Denied(strings.Join(violations, "; "))
+	}
+	return admission.Allowed("")
+}
+
+// decode returns the BridgeInstance of the request, a ClusterBridgeInstance is validated as a BridgeInstance
+// of its TargetNamespace
+func (v *validator) decode(kind string, raw runtime.RawExtension) (*v1alpha1.BridgeInstance, error) {
+	if kind != v1alpha1.ClusterBridgeInstanceKind {
+		bi := &v1alpha1.BridgeInstance{}
+		err := v.decoder.DecodeRaw(raw, bi)
+		return bi, err
+	}
+	cbi := &v1alpha1.ClusterBridgeInstance{}
+	if err := v.decoder.DecodeRaw(raw, cbi); err != nil {
+		return nil, err
+	}
+	cbi.SetGroupVersionKind(v1alpha1.SchemeGroupVersion.WithKind(v1alpha1.ClusterBridgeInstanceKind))
+	return cbi.BridgeInstance(), nil
+}
+
+// validateCRD checks the scope of the CRDSpec kind once it is served and that the other bridges of
+// the kind can't select the same CRD objects with the same priority
+func (v *validator) validateCRD(bi *v1alpha1.BridgeInstance) []string {
+	gvk := bi.Spec.CRDSpec.GroupVersionKind()
+	if gvk.Version == "" || gvk.Kind == "" {
+		return []string{"crdSpec apiVersion and kind must be set"}
+	}
+	var violations []string
+	mapper := restmapper.NewDeferredDiscoveryRESTMapper(memory.NewMemCacheClient(v.client.Discovery))
+	// the crd-controller waits for a CRD which is not served yet, its scope is checked once it is
+	if mapping, err := mapper.RESTMapping(gvk.GroupKind(), gvk.Version); err == nil {
+		if err := bridge.ValidateScope(bi, mapping.Scope); err != nil {
+			violations = append(violations, err.Error())
+		}
+	}
+
+	if bi.IsCluster() {
+		list, err := v.client.Bridge.KudobridgeV1alpha1().ClusterBridgeInstances().List(context.TODO(), metav1.ListOptions{})
+		if err != nil {
+			return append(violations, fmt.Sprintf("cannot list the ClusterBridgeInstances: %v", err))
+		}
+		for _, other := range list.Items {
+			if other.GetName() != bi.GetName() && conflicts(bi.Spec, other.Spec.BridgeInstanceSpec) {
+				violations = append(violations, fmt.Sprintf("ClusterBridgeInstance %s already targets %s with the priority %d and a crSelector selecting the same objects", other.GetName(), gvk, bi.Spec.Priority))
+			}
+		}
+		return violations
+	}
+	list, err := v.client.Bridge.KudobridgeV1alpha1().BridgeInstances(bi.GetNamespace()).List(context.TODO(), metav1.ListOptions{})
+	if err != nil {
+		return append(violations, fmt.Sprintf("cannot list the BridgeInstances of %s: %v", bi.GetNamespace(), err))
+	}
+	for _, other := range list.Items {
+		if other.GetName() != bi.GetName() && conflicts(bi.Spec, other.Spec) {
+			violations = append(violations, fmt.Sprintf("BridgeInstance %s/%s already targets %s with the priority %d and a crSelector selecting the same objects", other.GetNamespace(), other.GetName(), gvk, bi.Spec.Priority))
+		}
+	}
+	return violations
+}
+
+// conflicts returns true when both bridges target the same kind with the same priority and their CRSelectors can
+// select the same CRD objects
+func conflicts(bridge, other v1alpha1.BridgeInstanceSpec) bool {
+	if bridge.CRDSpec.GroupVersionKind() != other.CRDSpec.GroupVersionKind() || bridge.Priority != other.Priority {
+		return false
+	}
+	if bridge.CRSelector == nil || other.CRSelector == nil {
+		return true
+	}
+	return !disjoint(bridge.CRSelector.LabelSelector, other.CRSelector.LabelSelector) &&
+		!disjoint(bridge.CRSelector.NamespaceSelector, other.CRSelector.NamespaceSelector)
+}
+
+// disjoint returns true when no label set matches both selectors, a nil selector matches any label set.
+// Only the requirements on the same key are compared, e.g. tier=cache and tier=db or tier and !tier.
+func disjoint(a, b *metav1.LabelSelector) bool {
+	if a == nil || b == nil {
+		return false
+	}
+	sa, err := metav1.LabelSelectorAsSelector(a)
+	if err != nil {
+		return false
+	}
+	sb, err := metav1.LabelSelectorAsSelector(b)
+	if err != nil {
+		return false
+	}
+	ra, _ := sa.Requirements()
+	rb, _ := sb.Requirements()
+	for _, x := range ra {
+		for _, y := range rb {
+			if x.Key() == y.Key() && (excludes(x, y) || excludes(y, x)) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// excludes returns true when no label value satisfies both requirements on the same key
+func excludes(x, y labels.Requirement) bool {
+	switch x.Operator() {
+	case selection.In, selection.Equals, selection.DoubleEquals:
+		switch y.Operator() {
+		case selection.In, selection.Equals, selection.DoubleEquals:
+			return x.Values().Intersection(y.Values()).Len() == 0
+		case selection.NotIn, selection.NotEquals:
+			return y.Values().IsSuperset(x.Values())
+		case selection.DoesNotExist:
+			return true
+		}
+	case selection.Exists:
+		return y.Operator() == selection.DoesNotExist
+	}
+	return false
+}
+
+// validateCRSelector checks that the label selectors of the CRSelector are valid
+func validateCRSelector(s *v1alpha1.CRSelector) []string {
+	if s == nil {
+		return nil
+	}
+	var violations []string
+	if _, err := metav1.LabelSelectorAsSelector(s.LabelSelector); err != nil {
+		violations = append(violations, fmt.Sprintf("crSelector.labelSelector is invalid: %v", err))
+	}
+	if _, err := metav1.LabelSelectorAsSelector(s.NamespaceSelector); err != nil {
+		violations = append(violations, fmt.Sprintf("crSelector.namespaceSelector is invalid: %v", err))
+	}
+	return violations
+}
+
+// validateSources checks that each parameter mapping reads either a CRD field or a template
+func validateSources(mappings []v1alpha1.ParameterMapping) []string {
+	var violations []string
+	for _, m := range mappings {
+		switch {
+		case m.From != "" && m.Template != "":
+			violations = append(violations, fmt.Sprintf("parameter mapping %s must set either from or template, not both", m.To))
+		case m.From == "" && m.Template == "":
+			violations = append(violations, fmt.Sprintf("parameter mapping %s must set from or template", m.To))
+		case m.Template != "" && m.Reference != "":
+			violations = append(violations, fmt.Sprintf("parameter mapping %s can't reference a %s from a template", m.To, m.Reference))
+		}
+	}
+	return violations
+}
+
+// validateReferences checks that the mappings of Secret and ConfigMap references name the objects the crd-controller
+// is allowed to read
+func validateReferences(mappings []v1alpha1.ParameterMapping) []string {
+	var violations []string
+	for _, m := range mappings {
+		switch m.Reference {
+		case "":
+			if len(m.ReferenceNames) > 0 {
+				violations = append(violations, fmt.Sprintf("parameter mapping %s sets referenceNames without reference", m.To))
+			}
+		case v1alpha1.ReferenceSecret, v1alpha1.ReferenceConfigMap:
+			if len(m.ReferenceNames) == 0 {
+				violations = append(violations, fmt.Sprintf("parameter mapping %s must list the referenceNames of the %ss it can reference", m.To, m.Reference))
+			}
+		default:
+			violations = append(violations, fmt.Sprintf("parameter mapping %s reference must be %s or %s", m.To, v1alpha1.ReferenceSecret, v1alpha1.ReferenceConfigMap))
+		}
+	}
+	return violations
+}
+
+// validateOperator checks the KUDO Operator reference and the parameters used by the placeholders and the mappings
+func (v *validator) validateOperator(bi *v1alpha1.BridgeInstance) []string {
+	op := bi.Spec.KUDOOperator
+	var violations []string
+	if op.Package == "" {
+		violations = append(violations, "kudoOperator.package must be set")
+	}
+	if op.InClusterOperator {
+		if op.KUDORepository != "" {
+			violations = append(violations, "kudoOperator.repository must not be set with inClusterOperator")
+		}
+		if op.Version == "" {
+			violations = append(violations, "kudoOperator.version must be set with inClusterOperator")
+		}
+	} else if op.KUDORepository == "" {
+		violations = append(violations, "kudoOperator.repository must be set unless inClusterOperator is true")
+	}
+	if _, err := semver.NewConstraint(op.AllowedVersions); op.AllowedVersions != "" && err != nil {
+		violations = append(violations, fmt.Sprintf("kudoOperator.allowedVersions is not a semver range: %v", err))
+	}
+	if _, err := semver.NewConstraint(op.AllowedAppVersions); op.AllowedAppVersions != "" && err != nil {
+		violations = append(violations, fmt.Sprintf("kudoOperator.allowedAppVersions is not a semver range: %v", err))
+	}
+	if len(violations) > 0 {
+		return violations
+	}
+
+	ov, err := v.operatorVersion(bi.GetNamespace(), op)
+	if err != nil {
+		return []string{fmt.Sprintf("cannot resolve the KUDO Operator %s: %v", op.Package, err)}
+	}
+	ovParams := make(map[string]bool)
+	for _, p := range ov.Spec.Parameters {
+		ovParams[p.Name] = true
+	}
+
+	placeholders, err := params.Placeholders(*bi)
+	if err != nil {
+		return []string{err.Error()}
+	}
+	for name, key := range placeholders {
+		if !ovParams[name] {
+			violations = append(violations, fmt.Sprintf("placeholder ${%s} of crdSpec %s is not defined in the OperatorVersion %s", name, key, ov.GetName()))
+		}
+	}
+	for _, m := range bi.Spec.ParameterMappings {
+		if !ovParams[m.To] {
+			violations = append(violations, fmt.Sprintf("parameter mapping %s is not defined in the OperatorVersion %s", m.To, ov.GetName()))
+		}
+	}
+	// the placeholders are iterated from a map
+	sort.Strings(violations)
+	return violations
+}
+
+// operatorVersion resolves the OperatorVersion the crd-controller installs for the BridgeInstance
+func (v *validator) operatorVersion(namespace string, op v1alpha1.KUDOOperator) (*kudov1beta1.OperatorVersion, error) {
+	if op.InClusterOperator {
+		ovn := kudov1beta1.OperatorVersionName(op.Package, op.Version)
+		return v.client.KudoClient.KudoV1beta1().OperatorVersions(namespace).Get(context.TODO(), ovn, metav1.GetOptions{})
+	}
+	key := strings.Join([]string{op.KUDORepository, op.Package, op.Version, op.AppVersion}, "|")
+	if ov := v.resolved.get(key); ov != nil {
+		return ov, nil
+	}
+	repository, err := repo.NewClient(&repo.Configuration{
+		URL:  op.KUDORepository,
+		Name: "kudoBridge",
+	})
+	if err != nil {
+		return nil, err
+	}
+	p, err := resolver.New(repository).Resolve(op.Package, op.AppVersion, op.Version)
+	if err != nil {
+		return nil, err
+	}
+	v.resolved.add(key, p.Resources.OperatorVersion)
+	return p.Resources.OperatorVersion, nil
+}
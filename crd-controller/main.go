@@ -3,6 +3,7 @@ package main
 import (
 	"context"
 	"flag"
+	"time"
 
 	log "github.com/sirupsen/logrus"
 
@@ -11,9 +12,15 @@ import (
 )
 
 var (
-	groupVersion string
-	kind         string
-	namespace    string
+	groupVersion  string
+	kind          string
+	namespace     string
+	bridge        string
+	shared        bool
+	workers       int
+	maxRetries    int
+	retryDelay    time.Duration
+	maxRetryDelay time.Duration
 )
 
 func main() {
@@ -24,18 +31,31 @@ func main() {
 		return
 	}
 
+	if shared {
+		watcher.NewSharedManager(clientSet, workers, maxRetries, retryDelay, maxRetryDelay).Run(context.Background())
+		return
+	}
+
 	if groupVersion == "" || kind == "" {
 		log.Fatalf("missing groupversion of kind to watch [groupVersion=%s] [kind=%s]", groupVersion, kind)
 		return
 	}
-	cont := watcher.NewController(clientSet, groupVersion, kind, namespace)
-	cont.Run(context.Background())
+	cont := watcher.NewController(clientSet, groupVersion, kind, namespace, bridge, workers, maxRetries, retryDelay, maxRetryDelay)
+	if err := cont.Run(context.Background()); err != nil {
+		log.Fatalf("failed to run the controller: %v", err)
+	}
 }
 
 func init() {
 	flag.StringVar(&groupVersion, "group-version", "", "groupversion to watch")
 	flag.StringVar(&kind, "kind", "", "kind to watch")
 	flag.StringVar(&namespace, "ns", "", "namespace to watch")
+	flag.StringVar(&bridge, "bridge", "", "name of the bridge whose objects are processed, all the bridges of the kind when empty")
+	flag.BoolVar(&shared, "shared", false, "process the CRD objects of all the BridgeInstances, -group-version, -kind and -ns are ignored")
+	flag.IntVar(&workers, "workers", 4, "number of CRD objects processed concurrently")
+	flag.IntVar(&maxRetries, "max-retries", 10, "number of retries of a failed CRD object before its failure is reported in its status")
+	flag.DurationVar(&retryDelay, "retry-delay", 5*time.Second, "delay of the first retry of a failed CRD object, doubled on each retry")
+	flag.DurationVar(&maxRetryDelay, "max-retry-delay", 5*time.Minute, "maximum delay between the retries of a failed CRD object")
 	flag.Parse()
 
 	customFormatter := new(log.TextFormatter)
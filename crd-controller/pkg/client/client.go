@@ -9,6 +9,7 @@ import (
 	bridge "github.com/zmalik/kudo-bridge/bridge-controller/pkg/generated/clientset/versioned"
 	"k8s.io/client-go/discovery"
 	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/clientcmd"
 )
@@ -16,6 +17,7 @@ import (
 // Client provides access different K8S clients
 type Client struct {
 	KudoClient *kudo.Clientset
+	KubeClient kubernetes.Interface
 	Dynamic    dynamic.Interface
 	Discovery  discovery.DiscoveryInterface
 	Bridge     *bridge.Clientset
@@ -50,6 +52,10 @@ func GetKubeClient() (*Client, error) {
 	if err != nil {
 		return nil, fmt.Errorf("could not get KUDO client: %s", err)
 	}
+	kube, err := kubernetes.NewForConfig(config)
+	if err != nil {
+		return nil, fmt.Errorf("could not get Kubernetes client: %s", err)
+	}
 	dynamic, err := dynamic.NewForConfig(config)
 	if err != nil {
 		return nil, fmt.Errorf("could not get dynamic client: %s", err)
@@ -62,5 +68,5 @@ func GetKubeClient() (*Client, error) {
 	if err != nil {
 		return nil, fmt.Errorf("could not get Discovery client: %s", err)
 	}
-	return &Client{kudo, dynamic, discovery, bridge}, nil
+	return &Client{kudo, kube, dynamic, discovery, bridge}, nil
 }
@@ -15,6 +15,7 @@ import (
 	"github.com/kudobuilder/kudo/pkg/kudoctl/util/kudo"
 	"github.com/kudobuilder/kudo/pkg/kudoctl/util/repo"
 	log "github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
@@ -27,6 +28,7 @@ type KUDOClient struct {
 	c *client.Client
 
 	kc                *kudo.Client
+	namespace         string
 	kudoPackageName   string
 	version           string
 	appVersion        string
@@ -45,6 +47,7 @@ func NewKUDOClient(k *client.Client, bi v1alpha1.BridgeInstance) (*KUDOClient, e
 	return &KUDOClient{
 		c:                 k,
 		kc:                kc,
+		namespace:         bi.GetNamespace(),
 		kudoPackageName:   bi.Spec.KUDOOperator.Package,
 		version:           bi.Spec.KUDOOperator.Version,
 		appVersion:        bi.Spec.KUDOOperator.AppVersion,
@@ -54,21 +57,63 @@ func NewKUDOClient(k *client.Client, bi v1alpha1.BridgeInstance) (*KUDOClient, e
 }
 
 func (k *KUDOClient) GetOVOrInstall(crd *unstructured.Unstructured) (*v1beta1.OperatorVersion, error) {
-	if instance, err := k.kc.GetInstance(crd.GetName(), crd.GetNamespace()); err == nil && instance != nil {
+	if instance, err := k.kc.GetInstance(InstanceName(crd), k.namespace); err == nil && instance != nil {
 		// already installed
-		log.Infof("found the KUDO Instance %s/%s", crd.GetNamespace(), crd.GetName())
+		log.Infof("found the KUDO Instance %s/%s", k.namespace, instance.GetName())
 		log.Infof("fetching the KUDO Instance Operator %s/%s", instance.Spec.OperatorVersion.Name, instance.GetNamespace())
-		return k.kc.GetOperatorVersion(instance.Spec.OperatorVersion.Name, instance.GetNamespace())
+		ov, err := k.kc.GetOperatorVersion(instance.Spec.OperatorVersion.Name, instance.GetNamespace())
+		if err != nil || ov == nil || k.Matches(ov) {
+			return ov, err
+		}
+		// the Instance is upgraded to the OperatorVersion of the bridge or of the versions pinned by the CRD object
+		log.Infof("the KUDO Instance %s/%s runs the version %s of the app version %s, installing the version %q of the app version %q",
+			k.namespace, instance.GetName(), ov.Spec.Version, ov.Spec.AppVersion, k.version, k.appVersion)
 	}
 
 	// install OV
 	return k.InstallOV(crd)
 }
 
+// Matches returns true when the OperatorVersion has the version and app version of the KUDO Operator, the versions
+// left empty match any
+func (k *KUDOClient) Matches(ov *v1beta1.OperatorVersion) bool {
+	return (k.version == "" || ov.Spec.Version == k.version) && (k.appVersion == "" || ov.Spec.AppVersion == k.appVersion)
+}
+
 func (k *KUDOClient) InstallOV(crd *unstructured.Unstructured) (*v1beta1.OperatorVersion, error) {
+	p, r, err := k.resolve()
+	if err != nil {
+		return nil, err
+	}
+
+	k.resources = p.Resources
+	k.resolver = r
+	installOpts := install.Options{
+		SkipInstance:    true,
+		CreateNamespace: false,
+	}
+
+	parameters := make(map[string]string)
+	if err := install.Package(k.kc, InstanceName(crd), k.namespace, *k.resources, parameters, r, installOpts); err != nil {
+		return nil, err
+	}
+	return k.kc.GetOperatorVersion(k.resources.OperatorVersion.GetName(), k.resources.OperatorVersion.GetNamespace())
+}
+
+// ResolveOV returns the OperatorVersion the KUDO Operator resolves to, without installing it
+func (k *KUDOClient) ResolveOV() (*v1beta1.OperatorVersion, error) {
+	p, _, err := k.resolve()
+	if err != nil {
+		return nil, err
+	}
+	return p.Resources.OperatorVersion, nil
+}
+
+// resolve returns the package of the KUDO Operator from the cluster or from the repository
+func (k *KUDOClient) resolve() (*packages.Package, resolver.Resolver, error) {
 	var r resolver.Resolver
 	if k.inClusterOperator {
-		r = k.getClusterResolver(crd.GetNamespace())
+		r = k.getClusterResolver(k.namespace)
 	} else {
 
 		repoConfig := repo.Configuration{
@@ -78,32 +123,39 @@ func (k *KUDOClient) InstallOV(crd *unstructured.Unstructured) (*v1beta1.Operato
 
 		repository, err := repo.NewClient(&repoConfig)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 
 		r = resolver.New(repository)
 	}
 	p, err := r.Resolve(k.kudoPackageName, k.appVersion, k.version)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
+	return p, r, nil
+}
 
-	k.resources = p.Resources
-	k.resolver = r
-	installOpts := install.Options{
-		SkipInstance:    true,
-		CreateNamespace: false,
-	}
+// GetInstance returns the KUDO Instance of the CRD object
+func (k *KUDOClient) GetInstance(crd *unstructured.Unstructured) (*v1beta1.Instance, error) {
+	return k.kc.GetInstance(InstanceName(crd), k.namespace)
+}
 
-	parameters := make(map[string]string)
-	if err := install.Package(k.kc, crd.GetName(), crd.GetNamespace(), *k.resources, parameters, r, installOpts); err != nil {
-		return nil, err
+// InstanceName returns the name of the KUDO Instance recorded in the CRD object annotations, the CRD object name
+// when none is recorded
+func InstanceName(crd *unstructured.Unstructured) string {
+	if name := crd.GetAnnotations()[v1alpha1.InstanceNameAnnotation]; name != "" {
+		return name
 	}
-	return k.kc.GetOperatorVersion(k.resources.OperatorVersion.GetName(), k.resources.OperatorVersion.GetNamespace())
+	return crd.GetName()
+}
+
+// GetOperatorVersion returns the OperatorVersion of the KUDO Instance
+func (k *KUDOClient) GetOperatorVersion(instance *v1beta1.Instance) (*v1beta1.OperatorVersion, error) {
+	return k.kc.GetOperatorVersion(instance.Spec.OperatorVersion.Name, instance.GetNamespace())
 }
 
 func (k *KUDOClient) InstallOrUpdateInstance(crd *unstructured.Unstructured, ov *v1beta1.OperatorVersion, params map[string]string) error {
-	instance, err := k.kc.GetInstance(crd.GetName(), crd.GetNamespace())
+	instance, err := k.kc.GetInstance(InstanceName(crd), k.namespace)
 	if instance == nil && err == nil {
 		// install Instance
 		return k.InstallInstance(crd, ov, params)
@@ -112,7 +164,11 @@ func (k *KUDOClient) InstallOrUpdateInstance(crd *unstructured.Unstructured, ov
 		return err
 	}
 	// update existing instance
-	return k.upgrade(instance, crd, ov, params)
+	if err := k.upgrade(instance, crd, ov, params); err != nil {
+		return err
+	}
+	// the Instance may have been installed before the CRD object owned it, e.g. by a previous version of the bridge
+	return k.MarkOwnerReference(crd)
 }
 
 func (k *KUDOClient) InstallInstance(crd *unstructured.Unstructured, ov *v1beta1.OperatorVersion, params map[string]string) error {
@@ -121,7 +177,7 @@ func (k *KUDOClient) InstallInstance(crd *unstructured.Unstructured, ov *v1beta1
 		CreateNamespace: false,
 	}
 	log.Infof("from here %s", crd.GetName())
-	if err := install.Package(k.kc, crd.GetName(), crd.GetNamespace(), *k.resources, params, k.resolver, installOpts); err != nil {
+	if err := install.Package(k.kc, InstanceName(crd), k.namespace, *k.resources, params, k.resolver, installOpts); err != nil {
 		return err
 	}
 	return k.MarkOwnerReference(crd)
@@ -146,6 +202,9 @@ func (k *KUDOClient) upgrade(instance *v1beta1.Instance, crd *unstructured.Unstr
 		return err
 	}
 
+	if newVersion.LessThan(oldVersion) {
+		return fmt.Errorf("the KUDO Instance %s/%s runs the version %s, KUDO doesn't downgrade it to %s", instance.GetNamespace(), instance.GetName(), oldOv.Spec.Version, ov.Spec.Version)
+	}
 	if newVersion.Equal(oldVersion) {
 		// update the instance values only if parameters are changed
 		if !reflect.DeepEqual(instance.Spec.Parameters, params) {
@@ -159,20 +218,71 @@ func (k *KUDOClient) upgrade(instance *v1beta1.Instance, crd *unstructured.Unstr
 
 	return upgrade.OperatorVersion(k.kc, ov, instance.GetName(), params, k.resolver)
 }
+
+// MarkOwnerReference makes the CRD object the controller of its KUDO Instance, the other owner references are kept
 func (k *KUDOClient) MarkOwnerReference(crd *unstructured.Unstructured) error {
-	instance, err := k.kc.GetInstance(crd.GetName(), crd.GetNamespace())
+	instance, err := k.kc.GetInstance(InstanceName(crd), k.namespace)
 	if err != nil {
 		return err
 	}
-	instance.OwnerReferences = []metav1.OwnerReference{
-		{
-			APIVersion: crd.GetAPIVersion(),
-			Kind:       crd.GetKind(),
-			Name:       crd.GetName(),
-			UID:        crd.GetUID(),
-		},
+	if instance == nil {
+		return fmt.Errorf("no KUDO Instance %s/%s found", k.namespace, InstanceName(crd))
+	}
+	isController := true
+	owner := metav1.OwnerReference{
+		APIVersion:         crd.GetAPIVersion(),
+		Kind:               crd.GetKind(),
+		Name:               crd.GetName(),
+		UID:                crd.GetUID(),
+		Controller:         &isController,
+		BlockOwnerDeletion: &isController,
+	}
+
+	var refs []metav1.OwnerReference
+	for _, ref := range instance.OwnerReferences {
+		if ref.UID == owner.UID {
+			if reflect.DeepEqual(ref, owner) {
+				return nil
+			}
+			continue
+		}
+		if ref.Controller != nil && *ref.Controller {
+			return fmt.Errorf("the KUDO Instance %s/%s is already controlled by %s %s", k.namespace, instance.GetName(), ref.Kind, ref.Name)
+		}
+		refs = append(refs, ref)
+	}
+	instance.OwnerReferences = append(refs, owner)
+	_, err = k.c.KudoClient.KudoV1beta1().Instances(k.namespace).Update(context.TODO(), instance, metav1.UpdateOptions{})
+	return err
+}
+
+// ReleaseOwnerReference removes the CRD object from the owners of its KUDO Instance, so that the Instance
+// outlives the object
+func (k *KUDOClient) ReleaseOwnerReference(instance *v1beta1.Instance, crd *unstructured.Unstructured) error {
+	var refs []metav1.OwnerReference
+	for _, ref := range instance.OwnerReferences {
+		if ref.UID != crd.GetUID() {
+			refs = append(refs, ref)
+		}
+	}
+	if len(refs) == len(instance.OwnerReferences) {
+		return nil
+	}
+	updated := instance.DeepCopy()
+	updated.OwnerReferences = refs
+	_, err := k.c.KudoClient.KudoV1beta1().Instances(k.namespace).Update(context.TODO(), updated, metav1.UpdateOptions{})
+	return err
+}
+
+// DeleteInstance deletes the KUDO Instance, its resources are deleted before the Instance with the foreground
+// propagation and kept with the orphan propagation
+func (k *KUDOClient) DeleteInstance(instance *v1beta1.Instance, propagation metav1.DeletionPropagation) error {
+	err := k.c.KudoClient.KudoV1beta1().Instances(k.namespace).Delete(context.TODO(), instance.GetName(), metav1.DeleteOptions{
+		PropagationPolicy: &propagation,
+	})
+	if errors.IsNotFound(err) {
+		return nil
 	}
-	_, err = k.c.KudoClient.KudoV1beta1().Instances(crd.GetNamespace()).Update(context.TODO(), instance, metav1.UpdateOptions{})
 	return err
 }
 
@@ -0,0 +1,70 @@
+package params
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+
+	"github.com/kudobuilder/kudo/pkg/apis/kudo/v1beta1"
+	"github.com/kudobuilder/kudo/pkg/util/convert"
+)
+
+// maxExactFloat is the largest integer a float64 holds without losing precision
+const maxExactFloat = 1 << 53
+
+// toParamValue converts a CRD value to the string form KUDO expects for the given parameter type.
+// Map and array parameters are serialized as YAML, structured values of string parameters as JSON.
+func toParamValue(val interface{}, paramType v1beta1.ParameterType) (string, error) {
+	switch paramType {
+	case v1beta1.MapValueType, v1beta1.ArrayValueType:
+		if s, ok := val.(string); ok {
+			// already serialized in the CRD
+			return s, nil
+		}
+		wrapped, err := convert.WrapParamValue(val, paramType)
+		if err != nil {
+			return "", fmt.Errorf("cannot serialize %s value: %v", paramType, err)
+		}
+		return convert.StringValue(wrapped), nil
+	default:
+		return scalarString(val)
+	}
+}
+
+func scalarString(val interface{}) (string, error) {
+	switch v := val.(type) {
+	case nil:
+		return "", nil
+	case string:
+		return v, nil
+	case bool:
+		return strconv.FormatBool(v), nil
+	case int64:
+		return strconv.FormatInt(v, 10), nil
+	case int32:
+		return strconv.FormatInt(int64(v), 10), nil
+	case int:
+		return strconv.Itoa(v), nil
+	case float64:
+		return formatFloat(v), nil
+	case float32:
+		return formatFloat(float64(v)), nil
+	case map[string]interface{}, []interface{}:
+		b, err := json.Marshal(v)
+		if err != nil {
+			return "", fmt.Errorf("cannot serialize value: %v", err)
+		}
+		return string(b), nil
+	default:
+		return fmt.Sprintf("%v", v), nil
+	}
+}
+
+// formatFloat never uses the scientific notation, integral values are printed without decimals
+func formatFloat(f float64) string {
+	if f == math.Trunc(f) && math.Abs(f) <= maxExactFloat {
+		return strconv.FormatInt(int64(f), 10)
+	}
+	return strconv.FormatFloat(f, 'f', -1, 64)
+}
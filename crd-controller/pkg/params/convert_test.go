@@ -0,0 +1,71 @@
+package params
+
+import (
+	"testing"
+
+	"github.com/kudobuilder/kudo/pkg/apis/kudo/v1beta1"
+)
+
+func TestToParamValue(t *testing.T) {
+	tests := []struct {
+		name      string
+		val       interface{}
+		paramType v1beta1.ParameterType
+		want      string
+	}{
+		{name: "nil", val: nil, paramType: v1beta1.StringValueType, want: ""},
+		{name: "string", val: "cassandra", paramType: v1beta1.StringValueType, want: "cassandra"},
+		{name: "bool", val: true, paramType: v1beta1.StringValueType, want: "true"},
+		{name: "int64", val: int64(3), paramType: v1beta1.StringValueType, want: "3"},
+		{name: "int32", val: int32(-3), paramType: v1beta1.StringValueType, want: "-3"},
+		{name: "int", val: 42, paramType: v1beta1.StringValueType, want: "42"},
+		{name: "integral float", val: float64(3), paramType: v1beta1.StringValueType, want: "3"},
+		{name: "float", val: 0.5, paramType: v1beta1.StringValueType, want: "0.5"},
+		{name: "float32", val: float32(1.5), paramType: v1beta1.StringValueType, want: "1.5"},
+		{name: "object of a string parameter", val: map[string]interface{}{"b": int64(1), "a": "x"}, paramType: v1beta1.StringValueType, want: `{"a":"x","b":1}`},
+		{name: "list of a string parameter", val: []interface{}{"a", int64(1)}, paramType: v1beta1.StringValueType, want: `["a",1]`},
+		{name: "object of a map parameter", val: map[string]interface{}{"b": int64(1), "a": "x"}, paramType: v1beta1.MapValueType, want: "a: x\nb: 1\n"},
+		{name: "list of an array parameter", val: []interface{}{"a", "b"}, paramType: v1beta1.ArrayValueType, want: "- a\n- b\n"},
+		{name: "serialized map", val: "a: x", paramType: v1beta1.MapValueType, want: "a: x"},
+		{name: "serialized array", val: `["a","b"]`, paramType: v1beta1.ArrayValueType, want: `["a","b"]`},
+		{name: "other type", val: uint8(7), paramType: v1beta1.StringValueType, want: "7"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := toParamValue(tt.val, tt.paramType)
+			if err != nil {
+				t.Fatalf("toParamValue: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("toParamValue(%#v, %s) = %q, want %q", tt.val, tt.paramType, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFormatFloat(t *testing.T) {
+	tests := []struct {
+		in   float64
+		want string
+	}{
+		{in: 0, want: "0"},
+		{in: 3, want: "3"},
+		{in: -3, want: "-3"},
+		{in: 1e6, want: "1000000"},
+		{in: 1e21, want: "1000000000000000000000"},
+		{in: 1 << 53, want: "9007199254740992"},
+		{in: 0.5, want: "0.5"},
+		{in: -2.25, want: "-2.25"},
+		{in: 1e-7, want: "0.0000001"},
+		{in: 123456.789, want: "123456.789"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			if got := formatFloat(tt.in); got != tt.want {
+				t.Errorf("formatFloat(%v) = %q, want %q", tt.in, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,37 @@
+package params
+
+import (
+	"strconv"
+
+	"github.com/kudobuilder/kudo/pkg/apis/kudo/v1beta1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// The implicit parameters are set from the CRD object metadata when the OperatorVersion declares them
+const (
+	ImplicitName       = "BRIDGE_CR_NAME"
+	ImplicitNamespace  = "BRIDGE_CR_NAMESPACE"
+	ImplicitUID        = "BRIDGE_CR_UID"
+	ImplicitGeneration = "BRIDGE_CR_GENERATION"
+	ImplicitKind       = "BRIDGE_CR_KIND"
+	ImplicitAPIVersion = "BRIDGE_CR_API_VERSION"
+)
+
+// implicitParams returns the implicit parameters declared in the OperatorVersion
+func implicitParams(crd *unstructured.Unstructured, ovParamsMap map[string]v1beta1.Parameter) map[string]string {
+	implicit := map[string]string{
+		ImplicitName:       crd.GetName(),
+		ImplicitNamespace:  crd.GetNamespace(),
+		ImplicitUID:        string(crd.GetUID()),
+		ImplicitGeneration: strconv.FormatInt(crd.GetGeneration(), 10),
+		ImplicitKind:       crd.GetKind(),
+		ImplicitAPIVersion: crd.GetAPIVersion(),
+	}
+	params := make(map[string]string)
+	for name, val := range implicit {
+		if _, declared := ovParamsMap[name]; declared {
+			params[name] = val
+		}
+	}
+	return params
+}
@@ -0,0 +1,201 @@
+package params
+
+import (
+	"fmt"
+
+	"github.com/kudobuilder/kudo/pkg/apis/kudo/v1beta1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/zmalik/kudo-bridge/bridge-controller/pkg/apis/kudobridge/v1alpha1"
+	"github.com/zmalik/kudo-bridge/crd-controller/pkg/utils"
+)
+
+// Resolve returns the KUDO Instance parameters for the CRD object using the BridgeInstance mappings.
+// The explicit ParameterMappings are used when present, otherwise the placeholders in CRDSpec.
+func Resolve(crd *unstructured.Unstructured, bi v1alpha1.BridgeInstance, ov *v1beta1.OperatorVersion, refs References) (map[string]string, error) {
+	crdFlatMap, err := utils.Flatten(crd.UnstructuredContent(), utils.DefaultTokenizer)
+	if err != nil {
+		return nil, err
+	}
+	ovParamsMap, _ := getParamsMapFromOV(ov.Spec.Parameters)
+
+	var mapped map[string]string
+	if len(bi.Spec.ParameterMappings) > 0 {
+		mapped, err = fromMappings(crd, crdFlatMap, bi.GetNamespace(), bi.Spec.ParameterMappings, ovParamsMap, refs)
+	} else {
+		mapped, err = fromPlaceholders(crdFlatMap, bi, ovParamsMap)
+	}
+	if err != nil {
+		return nil, err
+	}
+	// the implicit parameters declared in the OperatorVersion are overridden by the mapped ones
+	params := implicitParams(crd, ovParamsMap)
+	for name, val := range mapped {
+		params[name] = val
+	}
+	return params, nil
+}
+
+// fromMappings resolves the ParameterMappings, the referenced Secrets and ConfigMaps are read in the namespace of the BridgeInstance
+func fromMappings(crd *unstructured.Unstructured, crdFlatMap *utils.Map, namespace string, mappings []v1alpha1.ParameterMapping, ovParamsMap map[string]v1beta1.Parameter, refs References) (map[string]string, error) {
+	params := make(map[string]string)
+	for _, m := range mappings {
+		p, exists := ovParamsMap[m.To]
+		if !exists {
+			return nil, fmt.Errorf("parameter %s is not defined in the OperatorVersion", m.To)
+		}
+		var (
+			val string
+			ok  bool
+			err error
+		)
+		if m.Template != "" {
+			val, ok, err = evalTemplate(m.To, m.Template, crd)
+			if err != nil {
+				return nil, err
+			}
+		} else {
+			val, ok, err = mappedValue(crdFlatMap, namespace, m, p, refs)
+			if err != nil {
+				return nil, fmt.Errorf("parameter %s: %v", m.To, err)
+			}
+		}
+		if ok {
+			params[m.To] = val
+			continue
+		}
+		if m.Default != nil {
+			params[m.To] = *m.Default
+			continue
+		}
+		if m.Required && m.Template != "" {
+			return nil, fmt.Errorf("required template for parameter %s renders no value", m.To)
+		}
+		if m.Required {
+			return nil, fmt.Errorf("required field %s for parameter %s is not set", m.From, m.To)
+		}
+	}
+	return params, nil
+}
+
+// mappedValue returns the value of the CRD field of the mapping, read from the referenced Secret or ConfigMap if any
+func mappedValue(crdFlatMap *utils.Map, namespace string, m v1alpha1.ParameterMapping, p v1beta1.Parameter, refs References) (string, bool, error) {
+	key, err := mappingKey(m.From)
+	if err != nil {
+		return "", false, err
+	}
+	crdVal, ok := crdFlatMap.Get(key)
+	if ok && m.Reference != "" {
+		crdVal, ok, err = referencedValue(refs, m.Reference, m.ReferenceNames, namespace, crdVal)
+		if err != nil {
+			return "", false, err
+		}
+	}
+	if !ok {
+		return "", false, nil
+	}
+	val, err := toParamValue(crdVal, p.Type)
+	if err != nil {
+		return "", false, err
+	}
+	return val, true, nil
+}
+
+// fromPlaceholders binds the CRD values found at the placeholder positions of the CRDSpec template.
+// A placeholder can bind a scalar, a whole object or list, or with a * key every element of a list.
+func fromPlaceholders(crdFlatMap *utils.Map, bi v1alpha1.BridgeInstance, ovParamsMap map[string]v1beta1.Parameter) (map[string]string, error) {
+	bridgeInstanceFlatMap, err := utils.Flatten(bi.Spec.CRDSpec.UnstructuredContent(), utils.DefaultTokenizer)
+	if err != nil {
+		return nil, err
+	}
+	if bi.Spec.PlaceholderSyntax == v1alpha1.PlaceholderSyntaxBare {
+		return fromBarePlaceholders(crdFlatMap, bridgeInstanceFlatMap, ovParamsMap)
+	}
+
+	params := make(map[string]string)
+	for key, val := range bridgeInstanceFlatMap.M {
+		s, ok := val.(string)
+		if !ok {
+			continue
+		}
+		t, err := parsePlaceholders(s)
+		if err != nil {
+			return nil, fmt.Errorf("crdSpec %s: %v", key, err)
+		}
+		if len(t.params) == 0 {
+			continue
+		}
+		for _, name := range t.params {
+			if _, exists := ovParamsMap[name]; !exists {
+				return nil, fmt.Errorf("placeholder ${%s} of crdSpec %s is not defined in the OperatorVersion", name, key)
+			}
+		}
+		crdVal, ok := crdFlatMap.Get(key)
+		if !ok {
+			continue
+		}
+
+		if t.whole() {
+			name := t.params[0]
+			paramVal, err := toParamValue(crdVal, ovParamsMap[name].Type)
+			if err != nil {
+				return nil, fmt.Errorf("parameter %s: %v", name, err)
+			}
+			params[name] = paramVal
+			continue
+		}
+		// the CRD value is composed of several placeholders
+		crdStr, err := scalarString(crdVal)
+		if err != nil {
+			return nil, fmt.Errorf("crdSpec %s: %v", key, err)
+		}
+		values, err := t.match(crdStr)
+		if err != nil {
+			return nil, fmt.Errorf("crdSpec %s: %v", key, err)
+		}
+		for name, v := range values {
+			params[name] = v
+		}
+	}
+	return params, nil
+}
+
+// fromBarePlaceholders binds the CRD values where the CRDSpec template value equals a parameter name
+func fromBarePlaceholders(crdFlatMap, bridgeInstanceFlatMap *utils.Map, ovParamsMap map[string]v1beta1.Parameter) (map[string]string, error) {
+	params := make(map[string]string)
+	for key, val := range bridgeInstanceFlatMap.M {
+		name, ok := val.(string)
+		if !ok {
+			continue
+		}
+		p, exists := ovParamsMap[name]
+		if !exists {
+			continue
+		}
+		if crdVal, ok := crdFlatMap.Get(key); ok {
+			paramVal, err := toParamValue(crdVal, p.Type)
+			if err != nil {
+				return nil, fmt.Errorf("parameter %s: %v", name, err)
+			}
+			params[name] = paramVal
+		}
+	}
+	return params, nil
+}
+
+// mappingKey converts a mapping path like .spec.size to the flattened key spec.size
+func mappingKey(from string) (string, error) {
+	path, err := utils.ParsePath(from)
+	if err != nil {
+		return "", err
+	}
+	return utils.DefaultTokenizer.Token(path), nil
+}
+
+func getParamsMapFromOV(parameters []v1beta1.Parameter) (map[string]v1beta1.Parameter, error) {
+	paramMap := make(map[string]v1beta1.Parameter)
+	for _, val := range parameters {
+		paramMap[val.Name] = val
+	}
+	return paramMap, nil
+}
@@ -0,0 +1,120 @@
+package params
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/zmalik/kudo-bridge/bridge-controller/pkg/apis/kudobridge/v1alpha1"
+	"github.com/zmalik/kudo-bridge/crd-controller/pkg/utils"
+)
+
+// placeholderTemplate is a CRDSpec string parsed into literals and ${PARAM} placeholders
+type placeholderTemplate struct {
+	// literals surrounding the placeholders, always one more than the placeholders
+	literals []string
+	params   []string
+}
+
+// parsePlaceholders parses the ${PARAM} placeholders of the string, $${ is kept as the literal ${
+func parsePlaceholders(s string) (*placeholderTemplate, error) {
+	t := &placeholderTemplate{}
+	var literal strings.Builder
+	for i := 0; i < len(s); i++ {
+		switch {
+		case strings.HasPrefix(s[i:], "$${"):
+			literal.WriteString("${")
+			i += 2
+		case strings.HasPrefix(s[i:], "${"):
+			end := strings.Index(s[i:], "}")
+			if end < 0 {
+				return nil, fmt.Errorf("unterminated placeholder in %q", s)
+			}
+			name := s[i+2 : i+end]
+			if name == "" {
+				return nil, fmt.Errorf("empty placeholder in %q", s)
+			}
+			t.literals = append(t.literals, literal.String())
+			t.params = append(t.params, name)
+			literal.Reset()
+			i += end
+		default:
+			literal.WriteByte(s[i])
+		}
+	}
+	t.literals = append(t.literals, literal.String())
+	return t, nil
+}
+
+// whole returns true when the template is a single placeholder binding the whole value
+func (t *placeholderTemplate) whole() bool {
+	return len(t.params) == 1 && t.literals[0] == "" && t.literals[1] == ""
+}
+
+// match extracts the placeholder values from a string composed with the template
+func (t *placeholderTemplate) match(val string) (map[string]string, error) {
+	var expr strings.Builder
+	expr.WriteString("^")
+	for i := range t.params {
+		expr.WriteString(regexp.QuoteMeta(t.literals[i]))
+		if i == len(t.params)-1 {
+			expr.WriteString("(.*)")
+			continue
+		}
+		expr.WriteString("(.*?)")
+	}
+	expr.WriteString(regexp.QuoteMeta(t.literals[len(t.literals)-1]))
+	expr.WriteString("$")
+
+	re, err := regexp.Compile(expr.String())
+	if err != nil {
+		return nil, err
+	}
+	groups := re.FindStringSubmatch(val)
+	if groups == nil {
+		return nil, fmt.Errorf("value %q doesn't match the placeholders %s", val, t)
+	}
+	values := make(map[string]string)
+	for i, p := range t.params {
+		values[p] = groups[i+1]
+	}
+	return values, nil
+}
+
+// String returns the template in the ${PARAM} syntax
+func (t *placeholderTemplate) String() string {
+	var s strings.Builder
+	for i, p := range t.params {
+		s.WriteString(strings.Replace(t.literals[i], "${", "$${", -1))
+		s.WriteString("${" + p + "}")
+	}
+	s.WriteString(strings.Replace(t.literals[len(t.literals)-1], "${", "$${", -1))
+	return s.String()
+}
+
+// Placeholders returns the parameters referenced by the ${PARAM} placeholders of the CRDSpec mapped to the
+// path of their field. With the Bare syntax any value may be a literal, no placeholder is returned.
+func Placeholders(bi v1alpha1.BridgeInstance) (map[string]string, error) {
+	placeholders := make(map[string]string)
+	if bi.Spec.PlaceholderSyntax == v1alpha1.PlaceholderSyntaxBare {
+		return placeholders, nil
+	}
+	bridgeInstanceFlatMap, err := utils.Flatten(bi.Spec.CRDSpec.UnstructuredContent(), utils.DefaultTokenizer)
+	if err != nil {
+		return nil, err
+	}
+	for key, val := range bridgeInstanceFlatMap.M {
+		s, ok := val.(string)
+		if !ok {
+			continue
+		}
+		t, err := parsePlaceholders(s)
+		if err != nil {
+			return nil, fmt.Errorf("crdSpec %s: %v", key, err)
+		}
+		for _, name := range t.params {
+			placeholders[name] = key
+		}
+	}
+	return placeholders, nil
+}
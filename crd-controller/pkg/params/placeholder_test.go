@@ -0,0 +1,154 @@
+package params
+
+import (
+	"reflect"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/zmalik/kudo-bridge/bridge-controller/pkg/apis/kudobridge/v1alpha1"
+	"github.com/zmalik/kudo-bridge/crd-controller/pkg/utils"
+)
+
+func TestParsePlaceholders(t *testing.T) {
+	tests := []struct {
+		in       string
+		literals []string
+		params   []string
+		whole    bool
+	}{
+		{in: "", literals: []string{""}},
+		{in: "cassandra", literals: []string{"cassandra"}},
+		{in: "${NODE_COUNT}", literals: []string{"", ""}, params: []string{"NODE_COUNT"}, whole: true},
+		{in: "${MEMORY}Mi", literals: []string{"", "Mi"}, params: []string{"MEMORY"}},
+		{in: "${NAME}-${ZONE}", literals: []string{"", "-", ""}, params: []string{"NAME", "ZONE"}},
+		{in: "$${HOME}/${DIR}", literals: []string{"${HOME}/", ""}, params: []string{"DIR"}},
+		{in: "$${HOME}", literals: []string{"${HOME}"}},
+		{in: "$5 and $", literals: []string{"$5 and $"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			got, err := parsePlaceholders(tt.in)
+			if err != nil {
+				t.Fatalf("parsePlaceholders(%q): %v", tt.in, err)
+			}
+			if !reflect.DeepEqual(got.literals, tt.literals) || !reflect.DeepEqual(got.params, tt.params) {
+				t.Errorf("parsePlaceholders(%q) = %q %q, want %q %q", tt.in, got.literals, got.params, tt.literals, tt.params)
+			}
+			if got.whole() != tt.whole {
+				t.Errorf("parsePlaceholders(%q).whole() = %v, want %v", tt.in, got.whole(), tt.whole)
+			}
+			if got.String() != tt.in {
+				t.Errorf("parsePlaceholders(%q).String() = %q", tt.in, got.String())
+			}
+		})
+	}
+
+	for _, invalid := range []string{"${NAME", "${}", "prefix-${}-suffix"} {
+		if _, err := parsePlaceholders(invalid); err == nil {
+			t.Errorf("parsePlaceholders(%q) succeeded, want an error", invalid)
+		}
+	}
+}
+
+func TestPlaceholderMatch(t *testing.T) {
+	tests := []struct {
+		template string
+		val      string
+		want     map[string]string
+	}{
+		{template: "${SIZE}", val: "3", want: map[string]string{"SIZE": "3"}},
+		{template: "${MEMORY}Mi", val: "512Mi", want: map[string]string{"MEMORY": "512"}},
+		{template: "${NAME}-${ZONE}", val: "cache-eu-west-1", want: map[string]string{"NAME": "cache", "ZONE": "eu-west-1"}},
+		{template: "$${HOME}/${DIR}", val: "${HOME}/data", want: map[string]string{"DIR": "data"}},
+		{template: "a.${X}*", val: "a.b*", want: map[string]string{"X": "b"}},
+		{template: "${EMPTY}Mi", val: "Mi", want: map[string]string{"EMPTY": ""}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.template, func(t *testing.T) {
+			p, err := parsePlaceholders(tt.template)
+			if err != nil {
+				t.Fatalf("parsePlaceholders(%q): %v", tt.template, err)
+			}
+			got, err := p.match(tt.val)
+			if err != nil {
+				t.Fatalf("match(%q): %v", tt.val, err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("match(%q) = %v, want %v", tt.val, got, tt.want)
+			}
+		})
+	}
+
+	for template, val := range map[string]string{"${MEMORY}Mi": "512Gi", "a.${X}": "abc", "${HOME}/x": "data"} {
+		p, err := parsePlaceholders(template)
+		if err != nil {
+			t.Fatalf("parsePlaceholders(%q): %v", template, err)
+		}
+		if _, err := p.match(val); err == nil {
+			t.Errorf("%q.match(%q) succeeded, want an error", template, val)
+		}
+	}
+}
+
+func TestPlaceholders(t *testing.T) {
+	crdSpec := unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "kudobridge.dev/v1",
+		"kind":       "Redis",
+		"spec": map[string]interface{}{
+			"replicas": "${REPLICAS}",
+			"memory":   "${MEMORY}Mi",
+			"image":    "redis:5",
+			"port":     int64(6379),
+			"zones":    []interface{}{"${ZONE}"},
+		},
+	}}
+	token := func(keys ...string) string {
+		return utils.DefaultTokenizer.Token(keys)
+	}
+	sigil := map[string]string{
+		"REPLICAS": token("spec", "replicas"),
+		"MEMORY":   token("spec", "memory"),
+		"ZONE":     token("spec", "zones", "0"),
+	}
+
+	tests := []struct {
+		name    string
+		syntax  v1alpha1.PlaceholderSyntax
+		crdSpec unstructured.Unstructured
+		want    map[string]string
+	}{
+		{name: "default syntax", crdSpec: crdSpec, want: sigil},
+		{name: "sigil syntax", syntax: v1alpha1.PlaceholderSyntaxSigil, crdSpec: crdSpec, want: sigil},
+		{name: "bare syntax", syntax: v1alpha1.PlaceholderSyntaxBare, crdSpec: crdSpec, want: map[string]string{}},
+		{
+			name: "escaped placeholders",
+			crdSpec: unstructured.Unstructured{Object: map[string]interface{}{
+				"spec": map[string]interface{}{"command": "echo $${HOME}"},
+			}},
+			want: map[string]string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bi := v1alpha1.BridgeInstance{Spec: v1alpha1.BridgeInstanceSpec{CRDSpec: tt.crdSpec, PlaceholderSyntax: tt.syntax}}
+			got, err := Placeholders(bi)
+			if err != nil {
+				t.Fatalf("Placeholders: %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Placeholders() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	bi := v1alpha1.BridgeInstance{Spec: v1alpha1.BridgeInstanceSpec{CRDSpec: unstructured.Unstructured{Object: map[string]interface{}{
+		"spec": map[string]interface{}{"replicas": "${REPLICAS"},
+	}}}}
+	if _, err := Placeholders(bi); err == nil {
+		t.Errorf("Placeholders() with an unterminated placeholder succeeded, want an error")
+	}
+}
@@ -0,0 +1,52 @@
+package params
+
+import (
+	"fmt"
+
+	"github.com/zmalik/kudo-bridge/bridge-controller/pkg/apis/kudobridge/v1alpha1"
+)
+
+// References resolves the keys of the Secrets and ConfigMaps referenced by the CRD objects
+type References interface {
+	// Value returns the value of the key, false when the object or the key doesn't exist
+	Value(kind v1alpha1.ReferenceKind, namespace, name, key string) (string, bool, error)
+}
+
+// referencedValue resolves a CRD field shaped like a secretKeyRef: {name: ..., key: ..., optional: ...}, only the
+// objects listed in names can be referenced
+func referencedValue(refs References, kind v1alpha1.ReferenceKind, names []string, namespace string, crdVal interface{}) (interface{}, bool, error) {
+	if kind != v1alpha1.ReferenceSecret && kind != v1alpha1.ReferenceConfigMap {
+		return nil, false, fmt.Errorf("unknown reference kind %s", kind)
+	}
+	ref, ok := crdVal.(map[string]interface{})
+	if !ok {
+		return nil, false, fmt.Errorf("%s reference must be an object with name and key", kind)
+	}
+	name, _ := ref["name"].(string)
+	key, _ := ref["key"].(string)
+	optional, _ := ref["optional"].(bool)
+	if name == "" || key == "" {
+		return nil, false, fmt.Errorf("%s reference must be an object with name and key", kind)
+	}
+	if !contains(names, name) {
+		return nil, false, fmt.Errorf("%s %s/%s is not in the referenceNames of the mapping", kind, namespace, name)
+	}
+
+	val, found, err := refs.Value(kind, namespace, name, key)
+	if err != nil {
+		return nil, false, err
+	}
+	if !found && !optional {
+		return nil, false, fmt.Errorf("key %s of %s %s/%s not found", key, kind, namespace, name)
+	}
+	return val, found, nil
+}
+
+func contains(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
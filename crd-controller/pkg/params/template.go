@@ -0,0 +1,32 @@
+package params
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/kudobuilder/kudo/pkg/engine/renderer"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// engine renders the mapping templates with the same functions available in the KUDO templates
+var engine = renderer.New()
+
+// evalTemplate renders the mapping template against the CRD object, the value is not set when the template renders
+// an empty string or reads a field missing from the CRD object
+func evalTemplate(name, tpl string, crd *unstructured.Unstructured) (val string, ok bool, err error) {
+	defer func() {
+		// a failing template must not take the worker down
+		if r := recover(); r != nil {
+			err = fmt.Errorf("template of parameter %s panicked: %v", name, r)
+		}
+	}()
+	val, err = engine.Render(name, tpl, crd.UnstructuredContent())
+	if err != nil {
+		// the renderer fails on the missing keys, text/template doesn't type this error
+		if strings.Contains(err.Error(), "map has no entry for key") {
+			return "", false, nil
+		}
+		return "", false, fmt.Errorf("template of parameter %s: %v", name, err)
+	}
+	return val, val != "", nil
+}
@@ -31,8 +31,49 @@ func (s StringTokenizer) Keys(ks string) []string { return strings.Split(ks, str
 // Separator returns the separator
 func (s StringTokenizer) Separator() string { return string(s) }
 
-// DefaultTokenizer is a tokenizer using a dot or fullstop
-var DefaultTokenizer = StringTokenizer(".")
+// EscapedTokenizer is a Tokenizer using the string as separator. The separators and backslashes
+// contained in the keys are escaped with a backslash, so keys like kudo.dev/foo survive the round trip.
+type EscapedTokenizer string
+
+// Token returns a token joining all the escaped keys with s as separator
+func (s EscapedTokenizer) Token(ks []string) string {
+	escaped := make([]string, len(ks))
+	for i, k := range ks {
+		k = strings.Replace(k, `\`, `\\`, -1)
+		escaped[i] = strings.Replace(k, string(s), `\`+string(s), -1)
+	}
+	return strings.Join(escaped, string(s))
+}
+
+// Keys returns the unescaped keys contained in the received token
+func (s EscapedTokenizer) Keys(ks string) []string {
+	sep := string(s)
+	var keys []string
+	var key strings.Builder
+	for i := 0; i < len(ks); i++ {
+		switch {
+		case ks[i] == '\\' && strings.HasPrefix(ks[i+1:], sep):
+			key.WriteString(sep)
+			i += len(sep)
+		case ks[i] == '\\' && i+1 < len(ks):
+			key.WriteByte(ks[i+1])
+			i++
+		case strings.HasPrefix(ks[i:], sep):
+			keys = append(keys, key.String())
+			key.Reset()
+			i += len(sep) - 1
+		default:
+			key.WriteByte(ks[i])
+		}
+	}
+	return append(keys, key.String())
+}
+
+// Separator returns the separator
+func (s EscapedTokenizer) Separator() string { return string(s) }
+
+// DefaultTokenizer is a tokenizer using a dot or fullstop, the dots in the keys are escaped
+var DefaultTokenizer = EscapedTokenizer(".")
 
 // Flatten takes a hierarchy and flatten it using the tokenizer supplied
 func Flatten(m map[string]interface{}, tokenizer Tokenizer) (*Map, error) {
@@ -60,6 +101,11 @@ func flatten(i interface{}, ks []string, update updateFunc) {
 }
 
 func flattenMap(m map[string]interface{}, ks []string, update updateFunc) {
+	if len(m) == 0 && len(ks) > 0 {
+		// keep empty objects, they would be lost otherwise
+		update(ks, m)
+		return
+	}
 	for k, v := range m {
 		flatten(v, append(ks, k), update)
 	}
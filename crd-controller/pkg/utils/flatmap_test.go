@@ -0,0 +1,197 @@
+package utils
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestFlattenExpandRoundTrip(t *testing.T) {
+	tests := []struct {
+		name string
+		in   map[string]interface{}
+	}{
+		{
+			name: "nested objects",
+			in: map[string]interface{}{
+				"spec": map[string]interface{}{"size": int64(3), "name": "cassandra"},
+			},
+		},
+		{
+			name: "dotted keys",
+			in: map[string]interface{}{
+				"metadata": map[string]interface{}{
+					"annotations": map[string]interface{}{"kudo.dev/foo": "bar", "a.b.c": "d"},
+				},
+				"spec": map[string]interface{}{
+					"config": map[string]interface{}{"jvm.options": "-Xmx1g"},
+				},
+			},
+		},
+		{
+			name: "backslashes",
+			in: map[string]interface{}{
+				"spec": map[string]interface{}{
+					`C:\path`:  "windows",
+					`a\`:       "trailing",
+					`a\.b`:     "escaped separator",
+					`\\server`: "double",
+				},
+			},
+		},
+		{
+			name: "wildcard characters",
+			in: map[string]interface{}{
+				"spec": map[string]interface{}{"*": "star", "a*b": "c"},
+			},
+		},
+		{
+			name: "hash keys",
+			in: map[string]interface{}{
+				"spec": map[string]interface{}{
+					"#":    "hash",
+					"tags": map[string]interface{}{"#": "1", "0": "zero"},
+					"racks": []interface{}{
+						map[string]interface{}{"#": "r1"},
+					},
+				},
+			},
+		},
+		{
+			name: "collections and empty objects",
+			in: map[string]interface{}{
+				"spec": map[string]interface{}{
+					"racks": []interface{}{
+						map[string]interface{}{"name": "r1", "labels": map[string]interface{}{"topology.kubernetes.io/zone": "a"}},
+						map[string]interface{}{"name": "r2", "labels": map[string]interface{}{}},
+					},
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, err := Flatten(tt.in, DefaultTokenizer)
+			if err != nil {
+				t.Fatalf("Flatten: %v", err)
+			}
+			if got := m.Expand(); !reflect.DeepEqual(got, tt.in) {
+				t.Errorf("Expand() = %#v, want %#v", got, tt.in)
+			}
+		})
+	}
+}
+
+func TestEscapedTokenizerRoundTrip(t *testing.T) {
+	tests := [][]string{
+		{"metadata", "annotations", "kudo.dev/foo"},
+		{"spec", `C:\path`},
+		{"spec", `a\`, "b"},
+		{"spec", `a\.b`},
+		{"spec", ".", ".."},
+		{"spec", "racks", "*", "name"},
+		{""},
+	}
+	for _, keys := range tests {
+		token := DefaultTokenizer.Token(keys)
+		if got := DefaultTokenizer.Keys(token); !reflect.DeepEqual(got, keys) {
+			t.Errorf("Keys(%q) = %q, want %q", token, got, keys)
+		}
+	}
+}
+
+func TestMapGet(t *testing.T) {
+	m, err := Flatten(map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"annotations": map[string]interface{}{"kudo.dev/foo": "bar"},
+		},
+		"spec": map[string]interface{}{
+			"#":      "hash",
+			"labels": map[string]interface{}{"#": "1"},
+			"config": map[string]interface{}{"jvm.options": "-Xmx1g", "gc": "g1"},
+			"racks": []interface{}{
+				map[string]interface{}{"name": "r1"},
+				map[string]interface{}{"name": "r2"},
+			},
+		},
+	}, DefaultTokenizer)
+	if err != nil {
+		t.Fatalf("Flatten: %v", err)
+	}
+
+	tests := []struct {
+		path  string
+		want  interface{}
+		found bool
+	}{
+		{path: `.metadata.annotations["kudo.dev/foo"]`, want: "bar", found: true},
+		{path: `.spec.config["jvm.options"]`, want: "-Xmx1g", found: true},
+		{path: ".spec.config", want: map[string]interface{}{"jvm.options": "-Xmx1g", "gc": "g1"}, found: true},
+		{path: ".spec.racks[1].name", want: "r2", found: true},
+		{path: ".spec.racks[*].name", want: []interface{}{"r1", "r2"}, found: true},
+		{path: ".spec.racks", want: []interface{}{
+			map[string]interface{}{"name": "r1"},
+			map[string]interface{}{"name": "r2"},
+		}, found: true},
+		{path: ".metadata.annotations.kudo", found: false},
+		{path: ".spec.missing", found: false},
+		{path: `.spec["#"]`, want: "hash", found: true},
+		{path: ".spec[*]", found: false},
+		{path: ".spec.labels[*]", found: false},
+		{path: ".spec.labels", want: map[string]interface{}{"#": "1"}, found: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			p, err := ParsePath(tt.path)
+			if err != nil {
+				t.Fatalf("ParsePath(%q): %v", tt.path, err)
+			}
+			got, found := m.Get(DefaultTokenizer.Token(p))
+			if found != tt.found {
+				t.Fatalf("Get(%q) found = %v, want %v", tt.path, found, tt.found)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Get(%q) = %#v, want %#v", tt.path, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParsePathRoundTrip(t *testing.T) {
+	tests := []struct {
+		path string
+		want Path
+	}{
+		{path: ".spec.size", want: Path{"spec", "size"}},
+		{path: "spec.size", want: Path{"spec", "size"}},
+		{path: `.metadata.annotations["kudo.dev/foo"]`, want: Path{"metadata", "annotations", "kudo.dev/foo"}},
+		{path: `.metadata.labels['app.kubernetes.io/name']`, want: Path{"metadata", "labels", "app.kubernetes.io/name"}},
+		{path: `.spec["a\\b"]`, want: Path{"spec", `a\b`}},
+		{path: ".spec.racks[0].name", want: Path{"spec", "racks", "0", "name"}},
+		{path: ".spec.racks[*].name", want: Path{"spec", "racks", "*", "name"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			got, err := ParsePath(tt.path)
+			if err != nil {
+				t.Fatalf("ParsePath(%q): %v", tt.path, err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("ParsePath(%q) = %q, want %q", tt.path, got, tt.want)
+			}
+			again, err := ParsePath(got.String())
+			if err != nil {
+				t.Fatalf("ParsePath(%q): %v", got.String(), err)
+			}
+			if !reflect.DeepEqual(again, tt.want) {
+				t.Errorf("ParsePath(%q) = %q, want %q", got.String(), again, tt.want)
+			}
+		})
+	}
+
+	for _, invalid := range []string{"", ".", ".spec..size", `.spec["size`, ".spec[x]"} {
+		if _, err := ParsePath(invalid); err == nil {
+			t.Errorf("ParsePath(%q) succeeded, want an error", invalid)
+		}
+	}
+}
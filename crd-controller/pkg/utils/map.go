@@ -182,6 +182,59 @@ func (m *Map) moveSliceAttribute(original, newKey string) {
 
 // Expand expands the Map into a more complex structure. This is the reverse of the Flatten operation.
 func (m *Map) Expand() map[string]interface{} {
+	res, _ := m.expand().(map[string]interface{})
+	return res
+}
+
+// Get returns the value stored under the key. When the key is the prefix of other keys, the expanded
+// subtree (object or collection) below it is returned. A * wildcard in the key returns the values
+// found for every element of the collection, e.g. spec.racks.*.name
+func (m *Map) Get(key string) (interface{}, bool) {
+	if v, ok := m.M[key]; ok {
+		return v, true
+	}
+
+	ks := m.t.Keys(key)
+	for i, k := range ks {
+		if k == "*" {
+			return m.getSliceAttribute(ks[:i], ks[i+1:])
+		}
+	}
+
+	sub, err := newMap(m.t)
+	if err != nil {
+		return nil, false
+	}
+	prefix := key + m.t.Separator()
+	for k, v := range m.M {
+		if strings.HasPrefix(k, prefix) {
+			sub.M[k[len(prefix):]] = v
+		}
+	}
+	if len(sub.M) == 0 {
+		return nil, false
+	}
+	return sub.expand(), true
+}
+
+func (m *Map) getSliceAttribute(prefix, remainder []string) (interface{}, bool) {
+	// a "#" key of the object itself, e.g. a string, isn't the size of a collection
+	size, ok := m.M[m.t.Token(append(prefix, "#"))].(int)
+	if !ok {
+		return nil, false
+	}
+
+	col := []interface{}{}
+	for i := 0; i < size; i++ {
+		ks := append(append(append([]string{}, prefix...), strconv.Itoa(i)), remainder...)
+		if v, ok := m.Get(m.t.Token(ks)); ok {
+			col = append(col, v)
+		}
+	}
+	return col, true
+}
+
+func (m *Map) expand() interface{} {
 	res := map[string]interface{}{}
 	hasCollections := false
 	for k, v := range m.M {
@@ -206,7 +259,7 @@ func (m *Map) Expand() map[string]interface{} {
 		return res
 	}
 
-	return m.expandNestedCollections(res).(map[string]interface{})
+	return m.expandNestedCollections(res)
 }
 
 func (m *Map) expandNestedCollections(original map[string]interface{}) interface{} {
@@ -216,12 +269,12 @@ func (m *Map) expandNestedCollections(original map[string]interface{}) interface
 		}
 	}
 
-	size, ok := original["#"]
+	size, ok := original["#"].(int)
 	if !ok {
 		return original
 	}
 
-	col := make([]interface{}, size.(int))
+	col := make([]interface{}, size)
 	for k := range col {
 		col[k] = original[strconv.Itoa(k)]
 	}
@@ -0,0 +1,100 @@
+package utils
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Path is a structured path of field names, e.g. [metadata annotations kudo.dev/foo]
+type Path []string
+
+// ParsePath parses a path like .spec.size, .metadata.annotations["kudo.dev/foo"] or .spec.racks[0].name.
+// Field names containing dots or brackets are written between quotes inside brackets.
+func ParsePath(s string) (Path, error) {
+	if s != "" && s[0] != '.' && s[0] != '[' {
+		s = "." + s
+	}
+	var p Path
+	for i := 0; i < len(s); {
+		switch s[i] {
+		case '.':
+			j := i + 1
+			for j < len(s) && s[j] != '.' && s[j] != '[' {
+				j++
+			}
+			if j == i+1 {
+				return nil, fmt.Errorf("empty field name at %d in %q", i, s)
+			}
+			p = append(p, s[i+1:j])
+			i = j
+		case '[':
+			name, next, err := parseBracket(s, i)
+			if err != nil {
+				return nil, err
+			}
+			p = append(p, name)
+			i = next
+		default:
+			return nil, fmt.Errorf("unexpected %q at %d in %q", s[i], i, s)
+		}
+	}
+	if len(p) == 0 {
+		return nil, fmt.Errorf("empty path %q", s)
+	}
+	return p, nil
+}
+
+// parseBracket parses the ["name"], ['name'] or [index] element starting at i
+func parseBracket(s string, i int) (string, int, error) {
+	if i+1 >= len(s) {
+		return "", 0, fmt.Errorf("unterminated bracket at %d in %q", i, s)
+	}
+	switch s[i+1] {
+	case '"':
+		j := i + 2
+		for j < len(s) && s[j] != '"' {
+			if s[j] == '\\' {
+				j++
+			}
+			j++
+		}
+		if j+1 >= len(s) || s[j+1] != ']' {
+			return "", 0, fmt.Errorf("unterminated bracket at %d in %q", i, s)
+		}
+		name, err := strconv.Unquote(s[i+1 : j+1])
+		if err != nil {
+			return "", 0, fmt.Errorf("invalid field name at %d in %q: %v", i, s, err)
+		}
+		return name, j + 2, nil
+	case '\'':
+		end := strings.Index(s[i+2:], "']")
+		if end < 0 {
+			return "", 0, fmt.Errorf("unterminated bracket at %d in %q", i, s)
+		}
+		return s[i+2 : i+2+end], i + 2 + end + 2, nil
+	default:
+		end := strings.Index(s[i:], "]")
+		if end < 0 {
+			return "", 0, fmt.Errorf("unterminated bracket at %d in %q", i, s)
+		}
+		index := s[i+1 : i+end]
+		if _, err := strconv.Atoi(index); err != nil && index != "*" {
+			return "", 0, fmt.Errorf("invalid index %q at %d in %q", index, i, s)
+		}
+		return index, i + end + 1, nil
+	}
+}
+
+// String returns the path in the ParsePath syntax
+func (p Path) String() string {
+	var s strings.Builder
+	for _, name := range p {
+		if name == "" || strings.ContainsAny(name, `.[]"' `) {
+			s.WriteString("[" + strconv.Quote(name) + "]")
+			continue
+		}
+		s.WriteString("." + name)
+	}
+	return s.String()
+}
@@ -0,0 +1,76 @@
+package validation
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/google/cel-go/cel"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/zmalik/kudo-bridge/bridge-controller/pkg/apis/kudobridge/v1alpha1"
+)
+
+var (
+	// env binds the CRD object to self
+	env, envErr = cel.NewEnv(cel.Variable("self", cel.DynType))
+
+	// programs caches the compiled rules, the rules only change with the BridgeInstance
+	programs sync.Map
+)
+
+// Validate evaluates the rules against the CRD object and returns the messages of the violated rules.
+// A rule that doesn't compile or fails to evaluate is reported as violated.
+func Validate(crd *unstructured.Unstructured, rules []v1alpha1.ValidationRule) ([]string, error) {
+	if envErr != nil {
+		return nil, envErr
+	}
+	var violations []string
+	for _, r := range rules {
+		prg, err := program(r.Rule)
+		if err != nil {
+			violations = append(violations, fmt.Sprintf("invalid rule %q: %v", r.Rule, err))
+			continue
+		}
+		out, _, err := prg.Eval(map[string]interface{}{"self": crd.UnstructuredContent()})
+		if err != nil {
+			violations = append(violations, fmt.Sprintf("%s: %v", message(r), err))
+			continue
+		}
+		ok, isBool := out.Value().(bool)
+		if !isBool {
+			violations = append(violations, fmt.Sprintf("rule %q returned %v instead of a bool", r.Rule, out.Value()))
+			continue
+		}
+		if !ok {
+			violations = append(violations, message(r))
+		}
+	}
+	return violations, nil
+}
+
+func message(r v1alpha1.ValidationRule) string {
+	if r.Message != "" {
+		return r.Message
+	}
+	return fmt.Sprintf("failed rule: %s", r.Rule)
+}
+
+// program compiles the rule once
+func program(rule string) (cel.Program, error) {
+	if prg, ok := programs.Load(rule); ok {
+		return prg.(cel.Program), nil
+	}
+	ast, iss := env.Compile(rule)
+	if iss.Err() != nil {
+		return nil, iss.Err()
+	}
+	if ast.OutputType() != cel.BoolType && ast.OutputType() != cel.DynType {
+		return nil, fmt.Errorf("rule must return a bool, not %v", ast.OutputType())
+	}
+	prg, err := env.Program(ast)
+	if err != nil {
+		return nil, err
+	}
+	programs.Store(rule, prg)
+	return prg, nil
+}
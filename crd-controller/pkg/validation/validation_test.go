@@ -0,0 +1,115 @@
+package validation
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/zmalik/kudo-bridge/bridge-controller/pkg/apis/kudobridge/v1alpha1"
+)
+
+func TestValidate(t *testing.T) {
+	crd := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "kudobridge.dev/v1",
+		"kind":       "Redis",
+		"metadata":   map[string]interface{}{"name": "cache", "labels": map[string]interface{}{"tier": "cache"}},
+		"spec": map[string]interface{}{
+			"replicas": int64(3),
+			"memory":   "1Gi",
+			"zones":    []interface{}{"a", "b"},
+		},
+	}}
+
+	tests := []struct {
+		name  string
+		rules []v1alpha1.ValidationRule
+		want  []string
+	}{
+		{name: "no rules"},
+		{
+			name: "satisfied rules",
+			rules: []v1alpha1.ValidationRule{
+				{Rule: "self.spec.replicas >= 1"},
+				{Rule: "self.metadata.labels.tier == 'cache'"},
+				{Rule: "size(self.spec.zones) == 2 && 'a' in self.spec.zones"},
+				{Rule: "has(self.spec.memory)"},
+				{Rule: "!has(self.spec.password)"},
+			},
+		},
+		{
+			name:  "violated rule with a message",
+			rules: []v1alpha1.ValidationRule{{Rule: "self.spec.replicas <= 2", Message: "at most 2 replicas"}},
+			want:  []string{"at most 2 replicas"},
+		},
+		{
+			name:  "violated rule without message",
+			rules: []v1alpha1.ValidationRule{{Rule: "self.spec.replicas <= 2"}},
+			want:  []string{"failed rule: self.spec.replicas <= 2"},
+		},
+		{
+			name: "violations in the rule order",
+			rules: []v1alpha1.ValidationRule{
+				{Rule: "self.spec.replicas % 2 == 0", Message: "even replicas"},
+				{Rule: "self.spec.replicas >= 1"},
+				{Rule: "self.spec.memory == '2Gi'", Message: "2Gi of memory"},
+			},
+			want: []string{"even replicas", "2Gi of memory"},
+		},
+		{
+			name:  "non bool rule",
+			rules: []v1alpha1.ValidationRule{{Rule: "self.spec.replicas + 1"}},
+			want:  []string{`invalid rule "self.spec.replicas + 1": rule must return a bool, not int`},
+		},
+		{
+			name:  "dynamic non bool result",
+			rules: []v1alpha1.ValidationRule{{Rule: "self.spec.memory"}},
+			want:  []string{`rule "self.spec.memory" returned 1Gi instead of a bool`},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Validate(crd, tt.rules)
+			if err != nil {
+				t.Fatalf("Validate: %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Validate() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidateErrors(t *testing.T) {
+	crd := &unstructured.Unstructured{Object: map[string]interface{}{
+		"spec": map[string]interface{}{"replicas": int64(3)},
+	}}
+
+	tests := []struct {
+		name   string
+		rule   v1alpha1.ValidationRule
+		prefix string
+	}{
+		{name: "syntax error", rule: v1alpha1.ValidationRule{Rule: "self.spec.replicas >="}, prefix: `invalid rule "self.spec.replicas >=": `},
+		{name: "undeclared variable", rule: v1alpha1.ValidationRule{Rule: "other.replicas > 1"}, prefix: `invalid rule "other.replicas > 1": `},
+		{name: "missing field", rule: v1alpha1.ValidationRule{Rule: "self.spec.size > 1", Message: "size above 1"}, prefix: "size above 1: "},
+		{name: "missing field without message", rule: v1alpha1.ValidationRule{Rule: "self.spec.size > 1"}, prefix: "failed rule: self.spec.size > 1: "},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// the compiled programs are cached, the rule is evaluated twice to cover the cached path
+			for i := 0; i < 2; i++ {
+				got, err := Validate(crd, []v1alpha1.ValidationRule{tt.rule})
+				if err != nil {
+					t.Fatalf("Validate: %v", err)
+				}
+				if len(got) != 1 || !strings.HasPrefix(got[0], tt.prefix) {
+					t.Errorf("Validate() = %q, want a single violation starting with %q", got, tt.prefix)
+				}
+			}
+		})
+	}
+}
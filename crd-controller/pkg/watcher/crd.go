@@ -0,0 +1,219 @@
+package watcher
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/discovery/cached/memory"
+	"k8s.io/client-go/dynamic/dynamicinformer"
+	"k8s.io/client-go/restmapper"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/retry"
+
+	"github.com/zmalik/kudo-bridge/bridge-controller/pkg/apis/kudobridge/v1alpha1"
+	"github.com/zmalik/kudo-bridge/crd-controller/pkg/client"
+)
+
+// reasonDiscoveryFailed is reported while the API server discovery doesn't serve an established CRD yet
+const reasonDiscoveryFailed = "DiscoveryFailed"
+
+var crdResource = apiextensionsv1.SchemeGroupVersion.WithResource("customresourcedefinitions")
+
+// crdState is the resolution of the watched kind, the mapping is nil while the kind can't be watched
+type crdState struct {
+	mapping *apimeta.RESTMapping
+	// versions lists the served versions of the CRD, their changes resolve the kind again
+	versions string
+	reason   string
+	message  string
+}
+
+// newCRDInformer returns the informer of the CustomResourceDefinitions, notify is called with the kind of the
+// changed CRDs
+func newCRDInformer(client *client.Client, notify func(schema.GroupKind)) cache.SharedIndexInformer {
+	informer := dynamicinformer.NewFilteredDynamicInformer(client.Dynamic, crdResource, metav1.NamespaceAll, 0, cache.Indexers{}, nil).Informer()
+	notifyKind := func(obj interface{}) {
+		if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+			obj = tombstone.Obj
+		}
+		if crd, err := toCRD(obj); err == nil {
+			notify(schema.GroupKind{Group: crd.Spec.Group, Kind: crd.Spec.Names.Kind})
+		}
+	}
+	informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    notifyKind,
+		UpdateFunc: func(old, new interface{}) { notifyKind(new) },
+		DeleteFunc: notifyKind,
+	})
+	return informer
+}
+
+// resolveCRD returns the mapping of the watched kind once its CRD is established and serves the watched version,
+// otherwise the reason the kind can't be watched
+func (c *Controller) resolveCRD(gvk schema.GroupVersionKind, crds cache.Store) crdState {
+	var crd *apiextensionsv1.CustomResourceDefinition
+	for _, obj := range crds.List() {
+		if found, err := toCRD(obj); err == nil && definesKind(found, gvk.GroupKind()) {
+			crd = found
+			break
+		}
+	}
+	if crd == nil {
+		// the kinds which aren't defined by a CRD, e.g. the aggregated APIs, are watched as soon as they are served
+		if mapping, err := c.restMapping(gvk); err == nil {
+			return crdState{mapping: mapping}
+		}
+		return crdState{reason: "CRDNotFound", message: fmt.Sprintf("waiting for the CRD of %s", gvk.GroupKind())}
+	}
+	if !crd.GetDeletionTimestamp().IsZero() {
+		return crdState{reason: "CRDDeleted", message: fmt.Sprintf("the CRD %s is being deleted", crd.GetName())}
+	}
+	if !established(crd) {
+		return crdState{reason: "CRDNotEstablished", message: fmt.Sprintf("waiting for the CRD %s to be established", crd.GetName())}
+	}
+
+	var served []string
+	for _, v := range crd.Spec.Versions {
+		if v.Served {
+			served = append(served, v.Name)
+		}
+	}
+	state := crdState{versions: strings.Join(served, ", ")}
+	if !containsString(served, gvk.Version) {
+		state.reason = "VersionNotServed"
+		state.message = fmt.Sprintf("the CRD %s serves the versions %s, waiting for %s", crd.GetName(), state.versions, gvk.Version)
+		return state
+	}
+	mapping, err := c.restMapping(gvk)
+	if err != nil {
+		state.reason = reasonDiscoveryFailed
+		state.message = err.Error()
+		return state
+	}
+	state.mapping = mapping
+	return state
+}
+
+// restMapping resolves the kind with a fresh discovery of the API server
+func (c *Controller) restMapping(gvk schema.GroupVersionKind) (*apimeta.RESTMapping, error) {
+	return restmapper.NewDeferredDiscoveryRESTMapper(memory.NewMemCacheClient(c.client.Discovery)).RESTMapping(gvk.GroupKind(), gvk.Version)
+}
+
+// reportWatched sets the CRDWatched condition of the bridges of the kind, only the bridge of the controller when
+// it has one. A ClusterBridgeInstance is reported when no BridgeInstance is.
+func (c *Controller) reportWatched(gvk schema.GroupVersionKind, status metav1.ConditionStatus, reason, message string) {
+	cond := v1alpha1.Condition{
+		Type:    v1alpha1.ConditionCRDWatched,
+		Status:  status,
+		Reason:  reason,
+		Message: message,
+	}
+	options := metav1.ListOptions{LabelSelector: bridgeSelector(gvk)}
+
+	reported := false
+	bridges := c.client.Bridge.KudobridgeV1alpha1().BridgeInstances(c.Namespace)
+	list, err := bridges.List(context.TODO(), options)
+	if err != nil {
+		log.Errorf("Error listing the BridgeInstances of %s in %s: %v", gvk, c.Namespace, err)
+	} else {
+		for _, bi := range list.Items {
+			if c.Bridge != "" && bi.GetName() != c.Bridge {
+				continue
+			}
+			reported = true
+			err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+				latest, err := bridges.Get(context.TODO(), bi.GetName(), metav1.GetOptions{})
+				if err != nil || !setBridgeCondition(latest.GetGeneration(), &latest.Status, cond) {
+					return err
+				}
+				_, err = bridges.UpdateStatus(context.TODO(), latest, metav1.UpdateOptions{})
+				return err
+			})
+			if err != nil {
+				log.Errorf("Error reporting the %s condition of BridgeInstance %s/%s: %v", cond.Type, bi.GetNamespace(), bi.GetName(), err)
+			}
+		}
+	}
+	if reported && c.Bridge != "" {
+		return
+	}
+
+	clusterBridges := c.client.Bridge.KudobridgeV1alpha1().ClusterBridgeInstances()
+	clusterList, err := clusterBridges.List(context.TODO(), options)
+	if err != nil {
+		log.Errorf("Error listing the ClusterBridgeInstances of %s: %v", gvk, err)
+		return
+	}
+	for _, cbi := range clusterList.Items {
+		if cbi.Spec.TargetNamespace != c.Namespace || (c.Bridge != "" && cbi.GetName() != c.Bridge) {
+			continue
+		}
+		err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+			latest, err := clusterBridges.Get(context.TODO(), cbi.GetName(), metav1.GetOptions{})
+			if err != nil || !setBridgeCondition(latest.GetGeneration(), &latest.Status, cond) {
+				return err
+			}
+			_, err = clusterBridges.UpdateStatus(context.TODO(), latest, metav1.UpdateOptions{})
+			return err
+		})
+		if err != nil {
+			log.Errorf("Error reporting the %s condition of ClusterBridgeInstance %s: %v", cond.Type, cbi.GetName(), err)
+		}
+	}
+}
+
+// setBridgeCondition sets the condition in the bridge status, false is returned when the status already holds it
+func setBridgeCondition(generation int64, status *v1alpha1.BridgeInstanceStatus, cond v1alpha1.Condition) bool {
+	cond.ObservedGeneration = generation
+	if existing := status.GetCondition(cond.Type); existing != nil && existing.Status == cond.Status &&
+		existing.Reason == cond.Reason && existing.Message == cond.Message && existing.ObservedGeneration == cond.ObservedGeneration {
+		return false
+	}
+	status.SetCondition(cond)
+	return true
+}
+
+// bridgeSelector returns the label selector of the bridges of the kind
+func bridgeSelector(gvk schema.GroupVersionKind) string {
+	return fmt.Sprintf("%s=%s,%s=%s,%s=%s", "version", gvk.Version, "kind", gvk.Kind, "group", gvk.Group)
+}
+
+func toCRD(obj interface{}) (*apiextensionsv1.CustomResourceDefinition, error) {
+	u, ok := obj.(*unstructured.Unstructured)
+	if !ok {
+		return nil, fmt.Errorf("unexpected CRD object %T", obj)
+	}
+	crd := &apiextensionsv1.CustomResourceDefinition{}
+	err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.UnstructuredContent(), crd)
+	return crd, err
+}
+
+func definesKind(crd *apiextensionsv1.CustomResourceDefinition, gk schema.GroupKind) bool {
+	return crd.Spec.Group == gk.Group && crd.Spec.Names.Kind == gk.Kind
+}
+
+func established(crd *apiextensionsv1.CustomResourceDefinition) bool {
+	for _, c := range crd.Status.Conditions {
+		if c.Type == apiextensionsv1.Established {
+			return c.Status == apiextensionsv1.ConditionTrue
+		}
+	}
+	return false
+}
+
+func containsString(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
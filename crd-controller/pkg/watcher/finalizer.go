@@ -0,0 +1,167 @@
+package watcher
+
+import (
+	"context"
+	"fmt"
+
+	kudov1beta1 "github.com/kudobuilder/kudo/pkg/apis/kudo/v1beta1"
+	log "github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/zmalik/kudo-bridge/bridge-controller/pkg/apis/kudobridge/v1alpha1"
+	"github.com/zmalik/kudo-bridge/crd-controller/pkg/client"
+	"github.com/zmalik/kudo-bridge/crd-controller/pkg/kudo"
+)
+
+// conditionFinalizable reports if a deletion policy applies to a deleted CRD object, it is only set when none does
+const conditionFinalizable = "Finalizable"
+
+// addInstanceFinalizer adds the finalizer applying the deletion policy to the CRD object, records the deletion policy
+// of the bridge and the namespace of the KUDO Instance in its annotations and returns the updated object
+func addInstanceFinalizer(client *client.Client, resource schema.GroupVersionResource, crd *unstructured.Unstructured, bi v1alpha1.BridgeInstance) (*unstructured.Unstructured, error) {
+	policy := bi.Spec.DeletionPolicy
+	if policy == "" {
+		policy = v1alpha1.DeletionPolicyDelete
+	}
+	annotations := crd.GetAnnotations()
+	if containsFinalizer(crd, v1alpha1.InstanceFinalizer) && annotations[v1alpha1.DeletionPolicyAnnotation] == string(policy) &&
+		annotations[v1alpha1.InstanceNamespaceAnnotation] == bi.GetNamespace() {
+		return crd, nil
+	}
+	updated := crd.DeepCopy()
+	if !containsFinalizer(crd, v1alpha1.InstanceFinalizer) {
+		updated.SetFinalizers(append(updated.GetFinalizers(), v1alpha1.InstanceFinalizer))
+	}
+	annotations = updated.GetAnnotations()
+	if annotations == nil {
+		annotations = make(map[string]string)
+	}
+	annotations[v1alpha1.DeletionPolicyAnnotation] = string(policy)
+	annotations[v1alpha1.InstanceNamespaceAnnotation] = bi.GetNamespace()
+	updated.SetAnnotations(annotations)
+	log.Infof("adding the finalizer %s with the deletion policy %s to %s/%s", v1alpha1.InstanceFinalizer, policy, crd.GetNamespace(), crd.GetName())
+	return client.Dynamic.Resource(resource).Namespace(crd.GetNamespace()).Update(context.TODO(), updated, metav1.UpdateOptions{})
+}
+
+// finalizeUnselected applies the recorded deletion policy to the KUDO Instance of a deleted CRD object no bridge
+// selects, e.g. after a relabel or a conflict of bridges. Without recorded policy the finalizer is kept and reported
+// in the Finalizable condition, the owner reference of the Instance would let the garbage collector delete it whatever
+// the policy of the bridge which created it.
+func finalizeUnselected(client *client.Client, resource schema.GroupVersionResource, crd *unstructured.Unstructured) error {
+	if !containsFinalizer(crd, v1alpha1.InstanceFinalizer) {
+		return nil
+	}
+	policy, ok := crd.GetAnnotations()[v1alpha1.DeletionPolicyAnnotation]
+	namespace := crd.GetAnnotations()[v1alpha1.InstanceNamespaceAnnotation]
+	if !ok || namespace == "" {
+		log.Infof("no KUDO Bridge Instance selects the deleted %s/%s and no deletion policy is recorded, keeping its finalizer", crd.GetNamespace(), crd.GetName())
+		updated := crd.DeepCopy()
+		cond := map[string]interface{}{
+			"type":    conditionFinalizable,
+			"status":  string(metav1.ConditionFalse),
+			"reason":  "NoDeletionPolicy",
+			"message": fmt.Sprintf("no bridge selects the object and no deletion policy is recorded, select it with a bridge or remove the finalizer %s", v1alpha1.InstanceFinalizer),
+		}
+		if err := setCondition(updated, cond); err != nil {
+			return fmt.Errorf("cannot set the %s condition of %s/%s: %v", conditionFinalizable, crd.GetNamespace(), crd.GetName(), err)
+		}
+		_, err := writeStatus(client, resource, crd, updated)
+		return err
+	}
+
+	bi := v1alpha1.BridgeInstance{}
+	bi.SetNamespace(namespace)
+	bi.Spec.DeletionPolicy = v1alpha1.DeletionPolicy(policy)
+	kc, err := kudo.NewKUDOClient(client, bi)
+	if err != nil {
+		return err
+	}
+	if reason := suspendReason(crd, bi); reason != "" {
+		// the finalizer is kept, the deletion policy is applied once resumed
+		return reportSuspended(client, resource, crd, bi, kc, nil, reason)
+	}
+	log.Infof("no KUDO Bridge Instance selects the deleted %s/%s, applying its recorded deletion policy %s", crd.GetNamespace(), crd.GetName(), policy)
+	return finalize(client, resource, crd, bi, kc)
+}
+
+// finalize applies the BridgeInstance deletion policy to the KUDO Instance of a deleted CRD object, the finalizer
+// is removed once the Instance is gone or released. The Instance deletion requeues the object.
+func finalize(client *client.Client, resource schema.GroupVersionResource, crd *unstructured.Unstructured, bi v1alpha1.BridgeInstance, kc *kudo.KUDOClient) error {
+	if !containsFinalizer(crd, v1alpha1.InstanceFinalizer) {
+		return nil
+	}
+	instance, err := kc.GetInstance(crd)
+	if err != nil {
+		return err
+	}
+
+	if instance != nil && !ownedBy(instance, crd) {
+		// installed by someone else, the deletion policy doesn't apply
+		log.Infof("the KUDO Instance %s/%s isn't owned by %s/%s, leaving it untouched", instance.GetNamespace(), instance.GetName(), crd.GetNamespace(), crd.GetName())
+		instance = nil
+	}
+	if instance != nil {
+		switch policy := bi.Spec.DeletionPolicy; policy {
+		case v1alpha1.DeletionPolicyRetain:
+			log.Infof("retaining the KUDO Instance %s/%s of %s/%s", instance.GetNamespace(), instance.GetName(), crd.GetNamespace(), crd.GetName())
+			if err := kc.ReleaseOwnerReference(instance, crd); err != nil {
+				return err
+			}
+		case v1alpha1.DeletionPolicyDelete, v1alpha1.DeletionPolicyOrphan, "":
+			if !instance.GetDeletionTimestamp().IsZero() {
+				// KUDO is cleaning up, e.g. running the cleanup plan
+				log.Infof("waiting for the KUDO Instance %s/%s to be deleted", instance.GetNamespace(), instance.GetName())
+				return nil
+			}
+			propagation := metav1.DeletePropagationForeground
+			if policy == v1alpha1.DeletionPolicyOrphan {
+				propagation = metav1.DeletePropagationOrphan
+			}
+			log.Infof("deleting the KUDO Instance %s/%s of %s/%s with the %s propagation", instance.GetNamespace(), instance.GetName(), crd.GetNamespace(), crd.GetName(), propagation)
+			return kc.DeleteInstance(instance, propagation)
+		default:
+			return fmt.Errorf("unknown deletion policy %s of %s/%s", policy, bi.GetNamespace(), bi.GetName())
+		}
+	}
+
+	return removeInstanceFinalizer(client, resource, crd)
+}
+
+// removeInstanceFinalizer releases the deletion of the CRD object
+func removeInstanceFinalizer(client *client.Client, resource schema.GroupVersionResource, crd *unstructured.Unstructured) error {
+	if !containsFinalizer(crd, v1alpha1.InstanceFinalizer) {
+		return nil
+	}
+	var finalizers []string
+	for _, f := range crd.GetFinalizers() {
+		if f != v1alpha1.InstanceFinalizer {
+			finalizers = append(finalizers, f)
+		}
+	}
+	updated := crd.DeepCopy()
+	updated.SetFinalizers(finalizers)
+	log.Infof("removing the finalizer %s of %s/%s", v1alpha1.InstanceFinalizer, crd.GetNamespace(), crd.GetName())
+	_, err := client.Dynamic.Resource(resource).Namespace(crd.GetNamespace()).Update(context.TODO(), updated, metav1.UpdateOptions{})
+	return err
+}
+
+// ownedBy returns true when the CRD object is an owner of the KUDO Instance
+func ownedBy(instance *kudov1beta1.Instance, crd *unstructured.Unstructured) bool {
+	for _, ref := range instance.GetOwnerReferences() {
+		if ref.UID == crd.GetUID() {
+			return true
+		}
+	}
+	return false
+}
+
+func containsFinalizer(crd *unstructured.Unstructured, finalizer string) bool {
+	for _, f := range crd.GetFinalizers() {
+		if f == finalizer {
+			return true
+		}
+	}
+	return false
+}
@@ -3,17 +3,20 @@ package watcher
 import (
 	"context"
 	"fmt"
-	"os"
 	"strings"
+	"sync"
 	"time"
 
+	kudov1beta1 "github.com/kudobuilder/kudo/pkg/apis/kudo/v1beta1"
+	bridgeinformers "github.com/zmalik/kudo-bridge/bridge-controller/pkg/generated/informers/externalversions/kudobridge/v1alpha1"
 	"github.com/zmalik/kudo-bridge/crd-controller/pkg/client"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
-	"k8s.io/client-go/discovery/cached/memory"
-	"k8s.io/client-go/restmapper"
 
 	log "github.com/sirupsen/logrus"
+	"golang.org/x/time/rate"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	uruntime "k8s.io/apimachinery/pkg/util/runtime"
@@ -24,60 +27,257 @@ import (
 )
 
 type Controller struct {
-	client     *client.Client
-	queue      workqueue.RateLimitingInterface
-	informer   cache.SharedIndexInformer
-	maxRetries int
+	client           *client.Client
+	queue            workqueue.RateLimitingInterface
+	informer         cache.SharedIndexInformer
+	instanceInformer cache.SharedIndexInformer
+	bridgeInformer   cache.SharedIndexInformer
+	references       *referenceTracker
+	resource         schema.GroupVersionResource
+	clusterScoped    bool
+	watchNamespace   string
+	shared           *SharedManager
+	workers          int
+	maxRetries       int
+	retryDelay       time.Duration
+	maxRetryDelay    time.Duration
 
 	GroupVersion string
 	Kind         string
 	Namespace    string
+	// Bridge is the name of the bridge of the controller, empty when it processes all the bridges of the kind
+	Bridge string
 }
 
-func NewController(client *client.Client, groupVersion, kind, namespace string) *Controller {
+// NewController returns the controller of the CRD objects, a failed object is retried maxRetries times with an
+// exponential backoff from retryDelay to maxRetryDelay
+func NewController(client *client.Client, groupVersion, kind, namespace, bridge string, workers, maxRetries int, retryDelay, maxRetryDelay time.Duration) *Controller {
 	return &Controller{
-		client:       client,
-		GroupVersion: groupVersion,
-		Kind:         kind,
-		Namespace:    namespace,
+		client:        client,
+		workers:       workers,
+		maxRetries:    maxRetries,
+		retryDelay:    retryDelay,
+		maxRetryDelay: maxRetryDelay,
+		GroupVersion:  groupVersion,
+		Kind:          kind,
+		Namespace:     namespace,
+		Bridge:        bridge,
 	}
 }
 
-func (c *Controller) Run(ctx context.Context) {
+// Run processes the CRD objects until the context is done. The objects are watched while the CRD of the kind is
+// established and serves the watched version, the state is reported in the CRDWatched condition of the bridges.
+func (c *Controller) Run(ctx context.Context) error {
 	group, version, err := getGroupVersion(c.GroupVersion)
 	if err != nil {
-		uruntime.HandleError(fmt.Errorf("error parsing group version"))
-		return
+		return err
 	}
-	gvk := &schema.GroupVersionKind{
+	gvk := schema.GroupVersionKind{
 		Group:   group,
 		Version: version,
 		Kind:    c.Kind,
 	}
-	meta, err := restmapper.NewDeferredDiscoveryRESTMapper(memory.NewMemCacheClient(c.client.Discovery)).RESTMapping(gvk.GroupKind(), gvk.Version)
-	if err != nil {
-		log.Errorf("Cannot watch the provided CRD :%v", err)
-		os.Exit(1)
+
+	changed := make(chan struct{}, 1)
+	notify := func() {
+		select {
+		case changed <- struct{}{}:
+		default:
+			// a resolution is already pending
+		}
+	}
+	var crdInformer cache.SharedIndexInformer
+	if c.shared != nil {
+		crdInformer = c.shared.watchCRD(c, gvk.GroupKind(), notify)
+		defer c.shared.unwatchCRD(c, gvk.GroupKind())
+	} else {
+		crdInformer = newCRDInformer(c.client, func(gk schema.GroupKind) {
+			if gk == gvk.GroupKind() {
+				notify()
+			}
+		})
+		go crdInformer.Run(ctx.Done())
+	}
+	if !cache.WaitForCacheSync(ctx.Done(), crdInformer.HasSynced) {
+		return fmt.Errorf("timed out waiting for the CRDs to sync")
+	}
+	notify()
+
+	var (
+		watched crdState
+		cancel  context.CancelFunc
+		stopped chan error
+	)
+	stop := func() {
+		if cancel != nil {
+			cancel()
+			<-stopped
+			cancel, stopped = nil, nil
+		}
+	}
+	defer stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case err := <-stopped:
+			// the watch stopped on its own
+			cancel()
+			cancel, stopped = nil, nil
+			return err
+		case <-changed:
+		}
+
+		state := c.resolveCRD(gvk, crdInformer.GetStore())
+		if state.mapping == nil {
+			if cancel != nil {
+				log.Infof("stopping the watch of %s: %s", gvk, state.message)
+				stop()
+			}
+			if state.reason == reasonDiscoveryFailed {
+				// the discovery lags behind the established CRDs
+				time.AfterFunc(c.retryDelay, notify)
+			}
+			c.reportWatched(gvk, metav1.ConditionFalse, state.reason, state.message)
+			watched = state
+			continue
+		}
+		if cancel != nil && state.versions == watched.versions && state.mapping.Resource == watched.mapping.Resource {
+			continue
+		}
+		if cancel != nil {
+			log.Infof("the served versions of %s changed to %s, watching it again", gvk.GroupKind(), state.versions)
+			stop()
+		}
+		watchCtx, watchCancel := context.WithCancel(ctx)
+		done := make(chan error, 1)
+		go func(mapping *apimeta.RESTMapping) {
+			done <- c.watch(watchCtx, mapping)
+		}(state.mapping)
+		cancel, stopped = watchCancel, done
+		watched = state
+		c.reportWatched(gvk, metav1.ConditionTrue, "Watching", fmt.Sprintf("watching %s", state.mapping.Resource))
+	}
+}
+
+// watch processes the CRD objects of the resource until the context is done, the workers are done when it returns
+func (c *Controller) watch(ctx context.Context, meta *apimeta.RESTMapping) error {
+	c.resource = meta.Resource
+	// the cluster scoped objects are watched in all namespaces, their KUDO Instances are created in c.Namespace
+	c.clusterScoped = meta.Scope.Name() == apimeta.RESTScopeNameRoot
+	c.watchNamespace = c.Namespace
+	if c.clusterScoped {
+		c.watchNamespace = metav1.NamespaceAll
 	}
 
 	stopCh := make(chan struct{})
 	defer close(stopCh)
-	c.queue = workqueue.NewRateLimitingQueue(workqueue.DefaultControllerRateLimiter())
-	c.informer = cache.NewSharedIndexInformer(
+	// the queue never hands the same key to two workers, a CRD object is processed by one worker at a time
+	c.queue = workqueue.NewRateLimitingQueue(workqueue.NewMaxOfRateLimiter(
+		workqueue.NewItemExponentialFailureRateLimiter(c.retryDelay, c.maxRetryDelay),
+		// the overall rate limit of workqueue.DefaultControllerRateLimiter
+		&workqueue.BucketRateLimiter{Limiter: rate.NewLimiter(rate.Limit(10), 100)},
+	))
+	defer c.queue.ShutDown()
+	c.references = newReferenceTracker(c.client, c.queue, stopCh)
+	if c.shared != nil {
+		c.informer = c.shared.acquire(c)
+		defer c.shared.release(c)
+	} else {
+		c.informer = cache.NewSharedIndexInformer(
+			&cache.ListWatch{
+				ListFunc: func(options metav1.ListOptions) (runtime.Object, error) {
+					return c.client.Dynamic.Resource(meta.Resource).Namespace(c.watchNamespace).List(context.TODO(), options)
+				},
+				WatchFunc: func(options metav1.ListOptions) (watch.Interface, error) {
+					return c.client.Dynamic.Resource(meta.Resource).Namespace(c.watchNamespace).Watch(context.TODO(), options)
+				},
+			},
+			&unstructured.Unstructured{},
+			0, //No resync
+			cache.Indexers{instanceNameIndex: indexByInstanceName},
+		)
+		c.informer.AddEventHandler(c.eventHandler())
+		go c.informer.Run(stopCh)
+	}
+
+	// KUDO Instance status changes requeue the owning CRD object and the ones conflicting with it
+	c.instanceInformer = cache.NewSharedIndexInformer(
 		&cache.ListWatch{
 			ListFunc: func(options metav1.ListOptions) (runtime.Object, error) {
-				return c.client.Dynamic.Resource(meta.Resource).Namespace(c.Namespace).List(context.TODO(), options)
+				return c.client.KudoClient.KudoV1beta1().Instances(c.Namespace).List(context.TODO(), options)
 			},
 			WatchFunc: func(options metav1.ListOptions) (watch.Interface, error) {
-				return c.client.Dynamic.Resource(meta.Resource).Namespace(c.Namespace).Watch(context.TODO(), options)
+				return c.client.KudoClient.KudoV1beta1().Instances(c.Namespace).Watch(context.TODO(), options)
 			},
 		},
-		&unstructured.Unstructured{},
+		&kudov1beta1.Instance{},
 		0, //No resync
 		cache.Indexers{},
 	)
 
-	c.informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+	c.instanceInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: c.enqueueOwner,
+		UpdateFunc: func(old, new interface{}) {
+			oldObj, _ := old.(*kudov1beta1.Instance)
+			newObj, _ := new.(*kudov1beta1.Instance)
+			if oldObj.GetResourceVersion() != newObj.GetResourceVersion() {
+				c.enqueueOwner(new)
+			}
+		},
+		// the deleted KUDO Instances release the finalizer of their CRD object
+		DeleteFunc: func(obj interface{}) {
+			if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+				obj = tombstone.Obj
+			}
+			c.enqueueOwner(obj)
+		},
+	})
+
+	// the BridgeInstance spec changes, e.g. resuming a suspended bridge, requeue all the CRD objects
+	c.bridgeInformer = c.newBridgeInformer()
+	c.bridgeInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		UpdateFunc: func(old, new interface{}) {
+			oldObj, _ := old.(metav1.Object)
+			newObj, _ := new.(metav1.Object)
+			if oldObj.GetGeneration() != newObj.GetGeneration() {
+				c.enqueueAll()
+			}
+		},
+	})
+
+	go c.instanceInformer.Run(stopCh)
+	go c.bridgeInformer.Run(stopCh)
+
+	log.Infoln("Controller started.")
+	if !cache.WaitForCacheSync(ctx.Done(), c.informer.HasSynced, c.instanceInformer.HasSynced, c.bridgeInformer.HasSynced) {
+		return fmt.Errorf("timed out waiting for caches to sync")
+	}
+	log.Infoln("Controller synced.")
+	if c.shared != nil {
+		// the objects listed before the controller joined the shared informer were dispatched to no controller
+		c.enqueueAll()
+	}
+
+	log.Infof("starting %d workers", c.workers)
+	var workers sync.WaitGroup
+	for i := 0; i < c.workers; i++ {
+		workers.Add(1)
+		go func() {
+			defer workers.Done()
+			wait.Until(c.runWorker, time.Second, ctx.Done())
+		}()
+	}
+	<-ctx.Done()
+	// the objects being processed are finished before the controller watches another resource
+	c.queue.ShutDown()
+	workers.Wait()
+	return nil
+}
+
+// eventHandler adds the changed CRD objects to the queue
+func (c *Controller) eventHandler() cache.ResourceEventHandler {
+	return cache.ResourceEventHandlerFuncs{
 		AddFunc: func(obj interface{}) {
 			key, err := cache.MetaNamespaceKeyFunc(obj)
 			if err == nil {
@@ -87,7 +287,9 @@ func (c *Controller) Run(ctx context.Context) {
 		UpdateFunc: func(old, new interface{}) {
 			oldObj, _ := old.(*unstructured.Unstructured)
 			newObj, _ := new.(*unstructured.Unstructured)
-			if oldObj.GetResourceVersion() != newObj.GetResourceVersion() {
+			// the status written by the controller, e.g. the failure of an object which exhausted its retries,
+			// doesn't requeue the object
+			if oldObj.GetResourceVersion() != newObj.GetResourceVersion() && !statusOnlyUpdate(oldObj, newObj) {
 				key, err := cache.MetaNamespaceKeyFunc(new)
 				if err == nil {
 					c.queue.Add(key)
@@ -101,19 +303,84 @@ func (c *Controller) Run(ctx context.Context) {
 				c.queue.Add(key)
 			}
 		},
-	})
+	}
+}
 
-	go c.informer.Run(stopCh)
+// statusOnlyUpdate returns true when the update of the CRD object only changed its status. The generation isn't
+// compared as the annotations don't increment it and the status does without status subresource.
+func statusOnlyUpdate(old, new *unstructured.Unstructured) bool {
+	strip := func(obj *unstructured.Unstructured) map[string]interface{} {
+		content := obj.DeepCopy().UnstructuredContent()
+		delete(content, "status")
+		if metadata, ok := content["metadata"].(map[string]interface{}); ok {
+			delete(metadata, "resourceVersion")
+			delete(metadata, "generation")
+			delete(metadata, "managedFields")
+		}
+		return content
+	}
+	return equality.Semantic.DeepEqual(strip(old), strip(new))
+}
 
-	log.Infoln("Controller started.")
-	if !cache.WaitForCacheSync(stopCh, c.informer.HasSynced) {
-		uruntime.HandleError(fmt.Errorf("timed out waiting for caches to sync"))
-		return
+// newBridgeInformer returns the informer of the BridgeInstances of the watched kind, or of the
+// ClusterBridgeInstances for a cluster scoped kind
+func (c *Controller) newBridgeInformer() cache.SharedIndexInformer {
+	selector := func(options *metav1.ListOptions) {
+		options.LabelSelector = bridgeSelector(c.resource.GroupVersion().WithKind(c.Kind))
 	}
-	log.Infoln("Controller synced.")
+	if c.clusterScoped {
+		return bridgeinformers.NewFilteredClusterBridgeInstanceInformer(c.client.Bridge, 0, cache.Indexers{}, selector)
+	}
+	return bridgeinformers.NewFilteredBridgeInstanceInformer(c.client.Bridge, c.Namespace, 0, cache.Indexers{}, selector)
+}
+
+// enqueueAll adds all the CRD objects to the queue
+func (c *Controller) enqueueAll() {
+	for _, key := range c.informer.GetStore().ListKeys() {
+		namespace, _, _ := cache.SplitMetaNamespaceKey(key)
+		if c.shared != nil && namespace != c.watchNamespace {
+			// the shared informer holds the objects of all the namespaces
+			continue
+		}
+		c.queue.Add(key)
+	}
+}
 
-	wait.Until(c.runWorker, time.Second, stopCh)
+// enqueueOwner adds the CRD object owning the KUDO Instance and the objects named after it to the queue
+func (c *Controller) enqueueOwner(obj interface{}) {
+	instance, ok := obj.(*kudov1beta1.Instance)
+	if !ok {
+		return
+	}
+	named, err := c.informer.GetIndexer().ByIndex(instanceNameIndex, instance.GetName())
+	if err != nil {
+		log.Errorf("Error finding the objects named after the KUDO Instance %s/%s: %v", instance.GetNamespace(), instance.GetName(), err)
+	}
+	for _, o := range named {
+		crd, ok := o.(*unstructured.Unstructured)
+		if !ok || (!c.clusterScoped && crd.GetNamespace() != instance.GetNamespace()) {
+			continue
+		}
+		if key, err := cache.MetaNamespaceKeyFunc(crd); err == nil {
+			c.queue.Add(key)
+		}
+	}
+	for _, ref := range instance.GetOwnerReferences() {
+		gv, err := schema.ParseGroupVersion(ref.APIVersion)
+		if err != nil {
+			continue
+		}
+		if ref.Kind != c.Kind || gv.Group != c.resource.Group {
+			continue
+		}
+		if c.clusterScoped {
+			c.queue.Add(ref.Name)
+			continue
+		}
+		c.queue.Add(fmt.Sprintf("%s/%s", instance.GetNamespace(), ref.Name))
+	}
 }
+
 func getGroupVersion(groupVersion string) (string, string, error) {
 	gv := strings.Split(groupVersion, "/")
 	if len(gv) != 2 {
@@ -138,6 +405,7 @@ func (c *Controller) processNext() bool {
 	err := c.processItem(key.(string))
 	if err == nil {
 		c.queue.Forget(key)
+		c.reportReconciled(key.(string), nil)
 	} else if c.queue.NumRequeues(key) < c.maxRetries {
 		log.Errorf("Error processing %s (will retry): %v", key, err)
 		c.queue.AddRateLimited(key)
@@ -145,10 +413,34 @@ func (c *Controller) processNext() bool {
 		log.Errorf("Error processing %s (giving up): %v", key, err)
 		c.queue.Forget(key)
 		uruntime.HandleError(err)
+		// the object is retried on its next spec or metadata change, or on a change of its KUDO Instance
+		c.reportReconciled(key.(string), err)
 	}
 	return true
 }
 
+// reportReconciled records the error of a CRD object which exhausted its retries in its status, the error is
+// cleared once the object is processed successfully
+func (c *Controller) reportReconciled(key string, processErr error) {
+	obj, exists, err := c.informer.GetIndexer().GetByKey(key)
+	if err != nil || !exists {
+		return
+	}
+	cached, ok := obj.(*unstructured.Unstructured)
+	if !ok || (processErr == nil && !hasFailed(cached)) {
+		return
+	}
+	// the cached object is outdated when the processing updated it
+	crd, err := c.client.Dynamic.Resource(c.resource).Namespace(cached.GetNamespace()).Get(context.TODO(), cached.GetName(), metav1.GetOptions{})
+	if err != nil {
+		log.Errorf("Error fetching %s to report its failure: %v", key, err)
+		return
+	}
+	if err := reportFailure(c.client, c.resource, crd, processErr); err != nil {
+		log.Errorf("Error reporting the failure of %s: %v", key, err)
+	}
+}
+
 func (c *Controller) processItem(key string) error {
 	obj, _, err := c.informer.GetIndexer().GetByKey(key)
 	if err != nil {
@@ -162,5 +454,5 @@ func (c *Controller) processItem(key string) error {
 		return fmt.Errorf("object with key %s is not a runtime.Object", key)
 	}
 
-	return Process(c.client, ro)
+	return Process(c.client, c.resource, c.Bridge, c.references.forObject(key), ro)
 }
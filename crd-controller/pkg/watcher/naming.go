@@ -0,0 +1,141 @@
+package watcher
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/kudobuilder/kudo/pkg/engine/renderer"
+	log "github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation"
+
+	"github.com/zmalik/kudo-bridge/bridge-controller/pkg/apis/kudobridge/v1alpha1"
+	"github.com/zmalik/kudo-bridge/crd-controller/pkg/client"
+	"github.com/zmalik/kudo-bridge/crd-controller/pkg/kudo"
+)
+
+const (
+	// conditionInstanceOwned reports if the KUDO Instance named after the CRD object is controlled by the object
+	conditionInstanceOwned = "InstanceOwned"
+	// instanceNameIndex indexes the CRD objects by the name of their KUDO Instance
+	instanceNameIndex = "instanceName"
+)
+
+// nameEngine renders the InstanceName templates with the same functions available in the KUDO templates
+var nameEngine = renderer.New()
+
+// recordInstanceName records the name of the KUDO Instance in the CRD object annotations and returns the updated
+// object. The name is rendered from the bridge InstanceName template once, a recorded name is kept.
+func recordInstanceName(client *client.Client, resource schema.GroupVersionResource, crd *unstructured.Unstructured, bi v1alpha1.BridgeInstance, kc *kudo.KUDOClient) (*unstructured.Unstructured, error) {
+	if _, ok := crd.GetAnnotations()[v1alpha1.InstanceNameAnnotation]; ok {
+		return crd, nil
+	}
+	name, err := renderInstanceName(crd, bi.Spec.InstanceName)
+	if err != nil {
+		return nil, fmt.Errorf("cannot name the KUDO Instance of %s/%s: %v", crd.GetNamespace(), crd.GetName(), err)
+	}
+	if name != crd.GetName() {
+		// the Instances installed before the template was set are named after the CRD object
+		instance, err := kc.GetInstance(crd)
+		if err != nil {
+			return nil, err
+		}
+		if instance != nil && ownedBy(instance, crd) {
+			name = instance.GetName()
+		}
+	}
+
+	updated := crd.DeepCopy()
+	annotations := updated.GetAnnotations()
+	if annotations == nil {
+		annotations = make(map[string]string)
+	}
+	annotations[v1alpha1.InstanceNameAnnotation] = name
+	updated.SetAnnotations(annotations)
+	log.Infof("naming the KUDO Instance of %s/%s %s", crd.GetNamespace(), crd.GetName(), name)
+	return client.Dynamic.Resource(resource).Namespace(crd.GetNamespace()).Update(context.TODO(), updated, metav1.UpdateOptions{})
+}
+
+// renderInstanceName evaluates the InstanceName template against the CRD object, the object name is returned
+// without template
+func renderInstanceName(crd *unstructured.Unstructured, tpl string) (name string, err error) {
+	if tpl == "" {
+		return crd.GetName(), nil
+	}
+	defer func() {
+		// a failing template must not take the worker down
+		if r := recover(); r != nil {
+			err = fmt.Errorf("instanceName template panicked: %v", r)
+		}
+	}()
+	gvk := crd.GroupVersionKind()
+	name, err = nameEngine.Render("instanceName", tpl, map[string]interface{}{
+		"Kind":      gvk.Kind,
+		"Name":      crd.GetName(),
+		"Namespace": crd.GetNamespace(),
+		"Group":     gvk.Group,
+		"Version":   gvk.Version,
+	})
+	if err != nil {
+		return "", fmt.Errorf("instanceName template: %v", err)
+	}
+	name = strings.TrimSpace(name)
+	if errs := validation.IsDNS1123Subdomain(name); len(errs) > 0 {
+		return "", fmt.Errorf("instanceName template rendered the invalid name %q: %s", name, strings.Join(errs, ", "))
+	}
+	return name, nil
+}
+
+// reportInstanceOwner reports in the InstanceOwned condition of the CRD status if the KUDO Instance is controlled
+// by another object, the Instance isn't adopted then. The condition is only reported once a conflict happened.
+// The updated CRD object is returned with false when the Instance is controlled by another object.
+func reportInstanceOwner(client *client.Client, resource schema.GroupVersionResource, crd *unstructured.Unstructured, kc *kudo.KUDOClient) (*unstructured.Unstructured, bool, error) {
+	instance, err := kc.GetInstance(crd)
+	if err != nil {
+		return nil, false, err
+	}
+	cond := map[string]interface{}{
+		"type":    conditionInstanceOwned,
+		"status":  string(metav1.ConditionTrue),
+		"reason":  "Owned",
+		"message": "",
+	}
+	owned := true
+	if instance != nil {
+		if ref := metav1.GetControllerOf(instance); ref != nil && ref.UID != crd.GetUID() {
+			owned = false
+			cond["status"] = string(metav1.ConditionFalse)
+			cond["reason"] = "InstanceConflict"
+			cond["message"] = fmt.Sprintf("the KUDO Instance %s/%s is controlled by %s %s", instance.GetNamespace(), instance.GetName(), ref.Kind, ref.Name)
+		}
+	}
+
+	if owned && !hasCondition(crd, conditionInstanceOwned) {
+		return crd, true, nil
+	}
+	updated := crd.DeepCopy()
+	if err := setCondition(updated, cond); err != nil {
+		return nil, false, fmt.Errorf("cannot set the %s condition of %s/%s: %v", conditionInstanceOwned, crd.GetNamespace(), crd.GetName(), err)
+	}
+	updated, err = writeStatus(client, resource, crd, updated)
+	if err != nil {
+		return nil, false, err
+	}
+	return updated, owned, nil
+}
+
+// indexByInstanceName indexes the CRD objects by the recorded name of their KUDO Instance, the conflicting objects
+// are requeued when the Instance changes
+func indexByInstanceName(obj interface{}) ([]string, error) {
+	crd, ok := obj.(*unstructured.Unstructured)
+	if !ok {
+		return nil, nil
+	}
+	if name := crd.GetAnnotations()[v1alpha1.InstanceNameAnnotation]; name != "" {
+		return []string{name}, nil
+	}
+	return nil, nil
+}
@@ -0,0 +1,73 @@
+package watcher
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/zmalik/kudo-bridge/bridge-controller/pkg/apis/kudobridge/v1alpha1"
+)
+
+func TestRenderInstanceName(t *testing.T) {
+	tests := []struct {
+		name string
+		tpl  string
+		want string
+		err  string
+	}{
+		{name: "no template", want: "cache"},
+		{name: "kind and name", tpl: "{{ .Kind | lower }}-{{ .Name }}", want: "redis-cache"},
+		{name: "namespace and name", tpl: "{{ .Namespace }}-{{ .Name }}", want: "dev-cache"},
+		{name: "group and version", tpl: "{{ .Name }}.{{ .Version }}.{{ .Group }}", want: "cache.v1.kudobridge.dev"},
+		{name: "surrounding spaces", tpl: " {{ .Name }}-instance\n", want: "cache-instance"},
+		{name: "invalid name", tpl: "{{ .Kind }}-{{ .Name }}", err: `instanceName template rendered the invalid name "Redis-cache": `},
+		{name: "empty name", tpl: "{{ if false }}{{ .Name }}{{ end }}", err: `instanceName template rendered the invalid name "": `},
+		{name: "unknown field", tpl: "{{ .Labels }}", err: "instanceName template: "},
+		{name: "parse error", tpl: "{{ .Name", err: "instanceName template: "},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := renderInstanceName(redis("dev", "cache", nil), tt.tpl)
+			if tt.err != "" {
+				if err == nil || !strings.HasPrefix(err.Error(), tt.err) {
+					t.Errorf("renderInstanceName(%q) = %q, %v, want an error starting with %q", tt.tpl, got, err, tt.err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("renderInstanceName(%q): %v", tt.tpl, err)
+			}
+			if got != tt.want {
+				t.Errorf("renderInstanceName(%q) = %q, want %q", tt.tpl, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIndexByInstanceName(t *testing.T) {
+	named := redis("dev", "cache", nil)
+	named.SetAnnotations(map[string]string{v1alpha1.InstanceNameAnnotation: "redis-cache"})
+
+	tests := []struct {
+		name string
+		obj  interface{}
+		want []string
+	}{
+		{name: "recorded name", obj: named, want: []string{"redis-cache"}},
+		{name: "no recorded name", obj: redis("dev", "cache", nil)},
+		{name: "not a CRD object", obj: "cache"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := indexByInstanceName(tt.obj)
+			if err != nil {
+				t.Fatalf("indexByInstanceName: %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("indexByInstanceName() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -4,19 +4,22 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"github.com/zmalik/kudo-bridge/crd-controller/pkg/utils"
 
-	"github.com/devopsfaith/flatmap"
-	"github.com/kudobuilder/kudo/pkg/apis/kudo/v1beta1"
 	log "github.com/sirupsen/logrus"
+	"github.com/zmalik/kudo-bridge/bridge-controller/pkg/apis/kudobridge/v1alpha1"
 	"github.com/zmalik/kudo-bridge/crd-controller/pkg/client"
 	"github.com/zmalik/kudo-bridge/crd-controller/pkg/kudo"
+	"github.com/zmalik/kudo-bridge/crd-controller/pkg/params"
+	"github.com/zmalik/kudo-bridge/crd-controller/pkg/validation"
 	"k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 )
 
-func Process(client *client.Client, item runtime.Object) error {
+// Process installs or updates the KUDO Instance of the CRD object, bridge restricts the processing to the objects
+// selected by the bridge of that name, all the bridges are processed when empty
+func Process(client *client.Client, resource schema.GroupVersionResource, bridge string, refs params.References, item runtime.Object) error {
 	if item == nil {
 		// Event was deleted
 		return nil
@@ -26,31 +29,129 @@ func Process(client *client.Client, item runtime.Object) error {
 	if !ok {
 		return errors.New("the CRD doesn't have unstructured.Unstructured spec")
 	}
-	labelSelector := fmt.Sprintf("%s=%s,%s=%s,%s=%s",
-		"version", crd.GroupVersionKind().Version, "kind", crd.GroupVersionKind().Kind, "group", crd.GroupVersionKind().Group)
 
 	//find bridge instance for the current CRD
-	bridgeInstanceList, err := client.Bridge.KudobridgeV1alpha1().BridgeInstances(crd.GetNamespace()).List(context.TODO(), v1.ListOptions{
-		LabelSelector: labelSelector,
-	})
-
+	bridgeInstances, err := findBridgeInstances(client, crd)
 	if err != nil {
-		log.Errorf("error retrieving KUDO Bridge Instances for %s : %v", labelSelector, err)
+		log.Errorf("error retrieving KUDO Bridge Instances for %s/%s : %v", crd.GetNamespace(), crd.GetName(), err)
 		return err
 	}
 
-	if len(bridgeInstanceList.Items) != 1 {
-		log.Errorf("Expecting 1 Bridge Instance but found %d", len(bridgeInstanceList.Items))
+	selected, updated, err := selectBridgeInstance(client, resource, crd, bridgeInstances)
+	if err != nil {
+		log.Errorf("Error selecting the KUDO Bridge Instance of %s/%s: %v", crd.GetNamespace(), crd.GetName(), err)
 		return err
 	}
+	crd = updated
+	if selected == nil {
+		log.Infof("no KUDO Bridge Instance selected for %s/%s", crd.GetNamespace(), crd.GetName())
+		if !crd.GetDeletionTimestamp().IsZero() {
+			return finalizeUnselected(client, resource, crd)
+		}
+		return nil
+	}
+	if bridge != "" && selected.GetName() != bridge {
+		// processed by the crd-controller of the selected bridge
+		return nil
+	}
+	bi := *selected
+
+	if !crd.GetDeletionTimestamp().IsZero() {
+		kc, err := kudo.NewKUDOClient(client, bi)
+		if err != nil {
+			log.Errorf("Error initializing KUDO Client :%v", err)
+			return err
+		}
+		if reason := suspendReason(crd, bi); reason != "" && containsFinalizer(crd, v1alpha1.InstanceFinalizer) {
+			// the finalizer is kept, the deletion policy is applied once resumed
+			return reportSuspended(client, resource, crd, bi, kc, refs, reason)
+		}
+		if err := finalize(client, resource, crd, bi, kc); err != nil {
+			log.Errorf("Error applying the deletion policy of %s/%s: %v", crd.GetNamespace(), crd.GetName(), err)
+			return err
+		}
+		return nil
+	}
+
+	var violations []string
+	rules := bi.Spec.Validations
+	if len(rules) > 0 {
+		violations, err = validation.Validate(crd, rules)
+		if err != nil {
+			log.Errorf("Error validating %s/%s: %v", crd.GetNamespace(), crd.GetName(), err)
+			return err
+		}
+	}
+	// the versions pinned by the annotations are installed and upgraded instead of the bridge ones
+	operator, versionViolations := pinnedOperator(crd, bi.Spec.KUDOOperator)
+	bi.Spec.KUDOOperator = operator
+	violations = append(violations, versionViolations...)
 
-	kc, err := kudo.NewKUDOClient(client, bridgeInstanceList.Items[0])
+	kc, err := kudo.NewKUDOClient(client, bi)
 	if err != nil {
 		log.Errorf("Error initializing KUDO Client :%v", err)
 		return err
 	}
+	if len(versionViolations) == 0 && pinsVersion(crd) {
+		downgrades, err := downgradeViolations(crd, operator, kc)
+		if err != nil {
+			log.Errorf("Error checking the versions pinned by %s/%s: %v", crd.GetNamespace(), crd.GetName(), err)
+			return err
+		}
+		violations = append(violations, downgrades...)
+	}
+	if len(rules) > 0 || pinsVersion(crd) || hasCondition(crd, conditionValid) {
+		validated, err := reportValidation(client, resource, crd, violations)
+		if err != nil {
+			log.Errorf("Error reporting the validation of %s/%s: %v", crd.GetNamespace(), crd.GetName(), err)
+			return err
+		}
+		crd = validated
+		if len(violations) > 0 {
+			// the violations are reported in the CRD status, KUDO is left untouched until the object is fixed
+			log.Infof("%s/%s doesn't satisfy the validation rules: %v", crd.GetNamespace(), crd.GetName(), violations)
+			return nil
+		}
+	}
 
-	log.Infof("checking if the KUDO Instance %s/%s is already installed", crd.GetNamespace(), crd.GetName())
+	named, err := recordInstanceName(client, resource, crd, bi, kc)
+	if err != nil {
+		log.Errorf("Error naming the KUDO Instance of %s/%s: %v", crd.GetNamespace(), crd.GetName(), err)
+		return err
+	}
+	crd = named
+	reported, owned, err := reportInstanceOwner(client, resource, crd, kc)
+	if err != nil {
+		log.Errorf("Error checking the owner of the KUDO Instance of %s/%s: %v", crd.GetNamespace(), crd.GetName(), err)
+		return err
+	}
+	crd = reported
+	if !owned {
+		// the conflict is reported in the CRD status, the object is requeued when the Instance changes
+		log.Infof("the KUDO Instance %s/%s is controlled by another object than %s/%s", bi.GetNamespace(), kudo.InstanceName(crd), crd.GetNamespace(), crd.GetName())
+		return nil
+	}
+
+	if reason := suspendReason(crd, bi); reason != "" {
+		// KUDO is left untouched, the pending changes are applied once resumed
+		return reportSuspended(client, resource, crd, bi, kc, refs, reason)
+	}
+	resumed, err := reportResumed(client, resource, crd)
+	if err != nil {
+		log.Errorf("Error reporting the resume of %s/%s: %v", crd.GetNamespace(), crd.GetName(), err)
+		return err
+	}
+	crd = resumed
+
+	// the finalizer is added before the KUDO Instance exists, it can't be deleted without the deletion policy
+	finalized, err := addInstanceFinalizer(client, resource, crd, bi)
+	if err != nil {
+		log.Errorf("Error adding the finalizer of %s/%s: %v", crd.GetNamespace(), crd.GetName(), err)
+		return err
+	}
+	crd = finalized
+
+	log.Infof("checking if the KUDO Instance %s/%s is already installed", bi.GetNamespace(), kudo.InstanceName(crd))
 	// get the operatorversion using bridgeInstance reference
 	ov, err := kc.GetOVOrInstall(crd)
 	if err != nil {
@@ -58,27 +159,49 @@ func Process(client *client.Client, item runtime.Object) error {
 		return err
 	}
 
-	crdFlatMap, _ := utils.Flatten(crd.UnstructuredContent(), flatmap.DefaultTokenizer)
-	bridgeInstanceFlatMap, _ := utils.Flatten(bridgeInstanceList.Items[0].Spec.CRDSpec.UnstructuredContent(), flatmap.DefaultTokenizer)
-	ovParamsMap, _ := getParamsMapFromOV(ov.Spec.Parameters)
-	instanceParamsToUpdate := make(map[string]string)
-	for key, val := range bridgeInstanceFlatMap.M {
-		if _, exists := ovParamsMap[fmt.Sprintf("%v", val)]; exists {
-			if crdVal, ok := crdFlatMap.M[key]; ok {
-				instanceParamsToUpdate[val.(string)] = fmt.Sprintf("%v", crdVal)
-			}
-		}
+	instanceParamsToUpdate, err := params.Resolve(crd, bi, ov, refs)
+	if err != nil {
+		log.Errorf("Error mapping the parameters of %s/%s: %v", crd.GetNamespace(), crd.GetName(), err)
+		return err
 	}
 	// OV is already installed
 	// Install Instance or Update/Upgrade the instance
-	return kc.InstallOrUpdateInstance(crd, ov, instanceParamsToUpdate)
+	if err := kc.InstallOrUpdateInstance(crd, ov, instanceParamsToUpdate); err != nil {
+		return err
+	}
 
+	instance, err := kc.GetInstance(crd)
+	if err != nil {
+		log.Errorf("Error fetching the KUDO Instance %s/%s: %v", bi.GetNamespace(), kudo.InstanceName(crd), err)
+		return err
+	}
+	return updateStatus(client, resource, crd, bi.Spec.StatusMappings, instance)
 }
 
-func getParamsMapFromOV(parameters []v1beta1.Parameter) (map[string]bool, error) {
-	paramMap := make(map[string]bool)
-	for _, val := range parameters {
-		paramMap[val.Name] = true
+// findBridgeInstances returns the BridgeInstances of the CRD object, the cluster scoped objects are bridged by
+// ClusterBridgeInstances returned as BridgeInstances of their TargetNamespace
+func findBridgeInstances(client *client.Client, crd *unstructured.Unstructured) ([]v1alpha1.BridgeInstance, error) {
+	labelSelector := fmt.Sprintf("%s=%s,%s=%s,%s=%s",
+		"version", crd.GroupVersionKind().Version, "kind", crd.GroupVersionKind().Kind, "group", crd.GroupVersionKind().Group)
+	options := v1.ListOptions{
+		LabelSelector: labelSelector,
+	}
+
+	if crd.GetNamespace() != "" {
+		bridgeInstanceList, err := client.Bridge.KudobridgeV1alpha1().BridgeInstances(crd.GetNamespace()).List(context.TODO(), options)
+		if err != nil {
+			return nil, err
+		}
+		return bridgeInstanceList.Items, nil
+	}
+	clusterBridgeInstanceList, err := client.Bridge.KudobridgeV1alpha1().ClusterBridgeInstances().List(context.TODO(), options)
+	if err != nil {
+		return nil, err
+	}
+	var bridgeInstances []v1alpha1.BridgeInstance
+	for _, cbi := range clusterBridgeInstanceList.Items {
+		cbi.SetGroupVersionKind(v1alpha1.SchemeGroupVersion.WithKind(v1alpha1.ClusterBridgeInstanceKind))
+		bridgeInstances = append(bridgeInstances, *cbi.BridgeInstance())
 	}
-	return paramMap, nil
+	return bridgeInstances, nil
 }
@@ -0,0 +1,166 @@
+package watcher
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/util/workqueue"
+
+	"github.com/zmalik/kudo-bridge/bridge-controller/pkg/apis/kudobridge/v1alpha1"
+	"github.com/zmalik/kudo-bridge/crd-controller/pkg/client"
+)
+
+// referenceResync is the period the referenced Secrets and ConfigMaps are fetched again to detect their changes
+const referenceResync = 30 * time.Second
+
+// referenceTracker resolves the Secrets and ConfigMaps referenced by the CRD objects and requeues
+// the objects when a referenced Secret or ConfigMap changes.
+// The RBAC of the bridges only allows to get the referenced objects by name, they can't be listed or watched:
+// the tracked objects are fetched again every referenceResync.
+type referenceTracker struct {
+	client *client.Client
+	queue  workqueue.RateLimitingInterface
+
+	lock sync.Mutex
+	// owners maps the referenced objects to the keys of the CRD objects referencing them
+	owners map[string]sets.String
+	// versions maps the referenced objects to their last resourceVersion, empty when they don't exist
+	versions map[string]string
+}
+
+func newReferenceTracker(client *client.Client, queue workqueue.RateLimitingInterface, stopCh <-chan struct{}) *referenceTracker {
+	t := &referenceTracker{
+		client:   client,
+		queue:    queue,
+		owners:   make(map[string]sets.String),
+		versions: make(map[string]string),
+	}
+	go wait.Until(t.resync, referenceResync, stopCh)
+	return t
+}
+
+// forObject returns the References of a CRD object, previously tracked references of the object are dropped
+func (t *referenceTracker) forObject(key string) *objectReferences {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	for ref, owners := range t.owners {
+		owners.Delete(key)
+		if owners.Len() == 0 {
+			delete(t.owners, ref)
+			delete(t.versions, ref)
+		}
+	}
+	return &objectReferences{tracker: t, key: key}
+}
+
+// track records the owner of the referenced object and its resourceVersion
+func (t *referenceTracker) track(kind v1alpha1.ReferenceKind, namespace, name, owner, version string) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	ref := referenceKey(kind, namespace, name)
+	if _, ok := t.owners[ref]; !ok {
+		t.owners[ref] = sets.NewString()
+	}
+	t.owners[ref].Insert(owner)
+	t.versions[ref] = version
+}
+
+// resync fetches the tracked objects and requeues the owners of the changed ones
+func (t *referenceTracker) resync() {
+	type reference struct {
+		kind            v1alpha1.ReferenceKind
+		namespace, name string
+	}
+	t.lock.Lock()
+	var refs []reference
+	for ref := range t.owners {
+		parts := strings.SplitN(ref, "/", 3)
+		refs = append(refs, reference{kind: v1alpha1.ReferenceKind(parts[0]), namespace: parts[1], name: parts[2]})
+	}
+	t.lock.Unlock()
+
+	for _, ref := range refs {
+		_, version, err := t.get(ref.kind, ref.namespace, ref.name)
+		if err != nil {
+			log.Errorf("Error fetching the %s %s/%s referenced by the CRD objects: %v", ref.kind, ref.namespace, ref.name, err)
+			continue
+		}
+		key := referenceKey(ref.kind, ref.namespace, ref.name)
+		t.lock.Lock()
+		if last, ok := t.versions[key]; ok && last != version {
+			t.versions[key] = version
+			for _, owner := range t.owners[key].List() {
+				t.queue.Add(owner)
+			}
+		}
+		t.lock.Unlock()
+	}
+}
+
+// get returns the referenced object and its resourceVersion, nil when it doesn't exist
+func (t *referenceTracker) get(kind v1alpha1.ReferenceKind, namespace, name string) (runtime.Object, string, error) {
+	var obj runtime.Object
+	var err error
+	switch kind {
+	case v1alpha1.ReferenceSecret:
+		obj, err = t.client.KubeClient.CoreV1().Secrets(namespace).Get(context.TODO(), name, metav1.GetOptions{})
+	case v1alpha1.ReferenceConfigMap:
+		obj, err = t.client.KubeClient.CoreV1().ConfigMaps(namespace).Get(context.TODO(), name, metav1.GetOptions{})
+	default:
+		return nil, "", fmt.Errorf("unknown reference kind %s", kind)
+	}
+	if errors.IsNotFound(err) {
+		return nil, "", nil
+	}
+	if err != nil {
+		return nil, "", err
+	}
+	o, ok := obj.(metav1.Object)
+	if !ok {
+		return nil, "", fmt.Errorf("unexpected %T referenced", obj)
+	}
+	return obj, o.GetResourceVersion(), nil
+}
+
+func referenceKey(kind v1alpha1.ReferenceKind, namespace, name string) string {
+	return fmt.Sprintf("%s/%s/%s", kind, namespace, name)
+}
+
+// objectReferences implements params.References for a single CRD object
+type objectReferences struct {
+	tracker *referenceTracker
+	key     string
+}
+
+// Value returns the value of the Secret or ConfigMap key and tracks the reference
+func (r *objectReferences) Value(kind v1alpha1.ReferenceKind, namespace, name, key string) (string, bool, error) {
+	obj, version, err := r.tracker.get(kind, namespace, name)
+	if err != nil {
+		return "", false, err
+	}
+	r.tracker.track(kind, namespace, name, r.key, version)
+	switch o := obj.(type) {
+	case nil:
+		return "", false, nil
+	case *corev1.Secret:
+		val, ok := o.Data[key]
+		return string(val), ok, nil
+	case *corev1.ConfigMap:
+		if val, ok := o.Data[key]; ok {
+			return val, true, nil
+		}
+		val, ok := o.BinaryData[key]
+		return string(val), ok, nil
+	}
+	return "", false, fmt.Errorf("unexpected %T referenced by %s", obj, r.key)
+}
@@ -0,0 +1,139 @@
+package watcher
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/zmalik/kudo-bridge/bridge-controller/pkg/apis/kudobridge/v1alpha1"
+	"github.com/zmalik/kudo-bridge/crd-controller/pkg/client"
+)
+
+// conditionBridgeSelected reports which bridge processes the CRD object when several bridges target its kind
+const conditionBridgeSelected = "BridgeSelected"
+
+// selectBridgeInstance returns the bridge with the highest priority among the ones selecting the CRD object.
+// When no bridge or several bridges with the same priority select the object, nil is returned and the reason
+// is reported in the BridgeSelected condition of the CRD status. The updated CRD object is returned.
+func selectBridgeInstance(client *client.Client, resource schema.GroupVersionResource, crd *unstructured.Unstructured, bridgeInstances []v1alpha1.BridgeInstance) (*v1alpha1.BridgeInstance, *unstructured.Unstructured, error) {
+	var namespaceLabels labels.Set
+	var candidates []v1alpha1.BridgeInstance
+	for _, bi := range bridgeInstances {
+		selected, err := selects(client, bi, crd, &namespaceLabels)
+		if err != nil {
+			return nil, nil, err
+		}
+		if selected {
+			candidates = append(candidates, bi)
+		}
+	}
+	// the candidates are sorted by priority then by name, the conflicts are reported in the same order
+	sort.Slice(candidates, func(i, j int) bool {
+		if candidates[i].Spec.Priority != candidates[j].Spec.Priority {
+			return candidates[i].Spec.Priority > candidates[j].Spec.Priority
+		}
+		return bridgeName(candidates[i]) < bridgeName(candidates[j])
+	})
+
+	cond := map[string]interface{}{
+		"type":    conditionBridgeSelected,
+		"status":  string(metav1.ConditionFalse),
+		"reason":  "NoBridgeSelected",
+		"message": fmt.Sprintf("none of the %d bridges of %s selects the object", len(bridgeInstances), crd.GroupVersionKind().Kind),
+	}
+	var selected *v1alpha1.BridgeInstance
+	switch {
+	case len(candidates) == 0:
+	case len(candidates) > 1 && candidates[1].Spec.Priority == candidates[0].Spec.Priority:
+		var conflicts []string
+		for _, bi := range candidates {
+			if bi.Spec.Priority == candidates[0].Spec.Priority {
+				conflicts = append(conflicts, bridgeName(bi))
+			}
+		}
+		cond["reason"] = "BridgeConflict"
+		cond["message"] = fmt.Sprintf("%s select the object with the same priority %d", strings.Join(conflicts, ", "), candidates[0].Spec.Priority)
+	default:
+		selected = &candidates[0]
+		cond["status"] = string(metav1.ConditionTrue)
+		cond["reason"] = "Selected"
+		cond["message"] = fmt.Sprintf("%s selects the object", bridgeName(*selected))
+	}
+
+	if selected != nil && len(bridgeInstances) == 1 && !hasCondition(crd, conditionBridgeSelected) {
+		// the condition is only reported for the kinds with several bridges
+		return selected, crd, nil
+	}
+	updated := crd.DeepCopy()
+	if err := setCondition(updated, cond); err != nil {
+		return nil, nil, fmt.Errorf("cannot set the %s condition of %s/%s: %v", conditionBridgeSelected, crd.GetNamespace(), crd.GetName(), err)
+	}
+	updated, err := writeStatus(client, resource, crd, updated)
+	if err != nil {
+		return nil, nil, err
+	}
+	return selected, updated, nil
+}
+
+// selects returns true when the CRD object matches the CRSelector of the bridge, the labels of the object
+// namespace are fetched once for all the bridges
+func selects(client *client.Client, bi v1alpha1.BridgeInstance, crd *unstructured.Unstructured, namespaceLabels *labels.Set) (bool, error) {
+	s := bi.Spec.CRSelector
+	if s == nil {
+		return true, nil
+	}
+	if s.LabelSelector != nil {
+		selector, err := metav1.LabelSelectorAsSelector(s.LabelSelector)
+		if err != nil {
+			return false, fmt.Errorf("invalid crSelector.labelSelector of %s: %v", bridgeName(bi), err)
+		}
+		if !selector.Matches(labels.Set(crd.GetLabels())) {
+			return false, nil
+		}
+	}
+	if s.NamespaceSelector != nil {
+		if crd.GetNamespace() == "" {
+			return false, nil
+		}
+		selector, err := metav1.LabelSelectorAsSelector(s.NamespaceSelector)
+		if err != nil {
+			return false, fmt.Errorf("invalid crSelector.namespaceSelector of %s: %v", bridgeName(bi), err)
+		}
+		if *namespaceLabels == nil {
+			ns, err := client.KubeClient.CoreV1().Namespaces().Get(context.TODO(), crd.GetNamespace(), metav1.GetOptions{})
+			if err != nil {
+				return false, err
+			}
+			*namespaceLabels = labels.Set(ns.GetLabels())
+		}
+		if !selector.Matches(*namespaceLabels) {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// bridgeName returns the kind and the name of the bridge
+func bridgeName(bi v1alpha1.BridgeInstance) string {
+	if bi.IsCluster() {
+		return fmt.Sprintf("%s %s", v1alpha1.ClusterBridgeInstanceKind, bi.GetName())
+	}
+	return fmt.Sprintf("BridgeInstance %s/%s", bi.GetNamespace(), bi.GetName())
+}
+
+// hasCondition returns true when the CRD status has a condition of the type
+func hasCondition(crd *unstructured.Unstructured, conditionType string) bool {
+	conditions, _, _ := unstructured.NestedSlice(crd.Object, "status", "conditions")
+	for _, c := range conditions {
+		if cond, ok := c.(map[string]interface{}); ok && cond["type"] == conditionType {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,177 @@
+package watcher
+
+import (
+	"context"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+
+	"github.com/zmalik/kudo-bridge/bridge-controller/pkg/apis/kudobridge/v1alpha1"
+	"github.com/zmalik/kudo-bridge/crd-controller/pkg/client"
+)
+
+var redisResource = schema.GroupVersionResource{Group: "kudobridge.dev", Version: "v1", Resource: "redis"}
+
+func redis(namespace, name string, labels map[string]string) *unstructured.Unstructured {
+	crd := &unstructured.Unstructured{}
+	crd.SetAPIVersion("kudobridge.dev/v1")
+	crd.SetKind("Redis")
+	crd.SetNamespace(namespace)
+	crd.SetName(name)
+	crd.SetLabels(labels)
+	return crd
+}
+
+func bridgeInstance(name string, priority int32, selector *v1alpha1.CRSelector) v1alpha1.BridgeInstance {
+	return v1alpha1.BridgeInstance{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "kudo-system", Name: name},
+		Spec:       v1alpha1.BridgeInstanceSpec{Priority: priority, CRSelector: selector},
+	}
+}
+
+func labelSelector(labels map[string]string) *v1alpha1.CRSelector {
+	return &v1alpha1.CRSelector{LabelSelector: &metav1.LabelSelector{MatchLabels: labels}}
+}
+
+func TestSelectBridgeInstance(t *testing.T) {
+	cache := bridgeInstance("cache", 0, labelSelector(map[string]string{"tier": "cache"}))
+	db := bridgeInstance("db", 0, labelSelector(map[string]string{"tier": "db"}))
+	fallback := bridgeInstance("fallback", -1, nil)
+	prod := bridgeInstance("prod", 5, &v1alpha1.CRSelector{
+		NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"env": "prod"}},
+	})
+	other := bridgeInstance("other", 0, nil)
+	another := bridgeInstance("another", 0, nil)
+
+	tests := []struct {
+		name     string
+		crd      *unstructured.Unstructured
+		bridges  []v1alpha1.BridgeInstance
+		selected string
+		reason   string
+		message  string
+	}{
+		{
+			name:     "label selector over a lower priority",
+			crd:      redis("dev", "a", map[string]string{"tier": "cache"}),
+			bridges:  []v1alpha1.BridgeInstance{fallback, cache, db},
+			selected: "cache",
+			reason:   "Selected",
+			message:  "BridgeInstance kudo-system/cache selects the object",
+		},
+		{
+			name:     "fallback without matching labels",
+			crd:      redis("dev", "a", nil),
+			bridges:  []v1alpha1.BridgeInstance{cache, db, fallback},
+			selected: "fallback",
+			reason:   "Selected",
+			message:  "BridgeInstance kudo-system/fallback selects the object",
+		},
+		{
+			name:     "namespace selector with the highest priority",
+			crd:      redis("prod", "a", map[string]string{"tier": "db"}),
+			bridges:  []v1alpha1.BridgeInstance{cache, db, fallback, prod},
+			selected: "prod",
+			reason:   "Selected",
+			message:  "BridgeInstance kudo-system/prod selects the object",
+		},
+		{
+			name:     "namespace selector not matching",
+			crd:      redis("dev", "a", map[string]string{"tier": "db"}),
+			bridges:  []v1alpha1.BridgeInstance{prod, db},
+			selected: "db",
+			reason:   "Selected",
+			message:  "BridgeInstance kudo-system/db selects the object",
+		},
+		{
+			name:     "namespace selector and cluster scoped object",
+			crd:      redis("", "a", nil),
+			bridges:  []v1alpha1.BridgeInstance{prod, fallback},
+			selected: "fallback",
+			reason:   "Selected",
+			message:  "BridgeInstance kudo-system/fallback selects the object",
+		},
+		{
+			name:    "conflict with the same priority",
+			crd:     redis("dev", "a", nil),
+			bridges: []v1alpha1.BridgeInstance{other, fallback, another},
+			reason:  "BridgeConflict",
+			message: "BridgeInstance kudo-system/another, BridgeInstance kudo-system/other select the object with the same priority 0",
+		},
+		{
+			name:     "conflict resolved by a higher priority",
+			crd:      redis("prod", "a", nil),
+			bridges:  []v1alpha1.BridgeInstance{other, another, prod},
+			selected: "prod",
+			reason:   "Selected",
+			message:  "BridgeInstance kudo-system/prod selects the object",
+		},
+		{
+			name:    "no bridge selects the object",
+			crd:     redis("dev", "a", map[string]string{"tier": "web"}),
+			bridges: []v1alpha1.BridgeInstance{cache, db},
+			reason:  "NoBridgeSelected",
+			message: "none of the 2 bridges of Redis selects the object",
+		},
+		{
+			name:     "single bridge",
+			crd:      redis("dev", "a", map[string]string{"tier": "cache"}),
+			bridges:  []v1alpha1.BridgeInstance{cache},
+			selected: "cache",
+		},
+		{
+			name:    "single bridge not selecting",
+			crd:     redis("dev", "a", nil),
+			bridges: []v1alpha1.BridgeInstance{cache},
+			reason:  "NoBridgeSelected",
+			message: "none of the 1 bridges of Redis selects the object",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &client.Client{
+				KubeClient: kubefake.NewSimpleClientset(
+					&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "dev", Labels: map[string]string{"env": "dev"}}},
+					&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "prod", Labels: map[string]string{"env": "prod"}}},
+				),
+				Dynamic: dynamicfake.NewSimpleDynamicClient(runtime.NewScheme()),
+			}
+			if _, err := c.Dynamic.Resource(redisResource).Namespace(tt.crd.GetNamespace()).Create(context.TODO(), tt.crd, metav1.CreateOptions{}); err != nil {
+				t.Fatalf("Create: %v", err)
+			}
+			selected, updated, err := selectBridgeInstance(c, redisResource, tt.crd, tt.bridges)
+			if err != nil {
+				t.Fatalf("selectBridgeInstance: %v", err)
+			}
+			var name string
+			if selected != nil {
+				name = selected.GetName()
+			}
+			if name != tt.selected {
+				t.Errorf("selected = %q, want %q", name, tt.selected)
+			}
+
+			conditions, _, _ := unstructured.NestedSlice(updated.Object, "status", "conditions")
+			if tt.reason == "" {
+				if len(conditions) != 0 {
+					t.Errorf("conditions = %v, want none", conditions)
+				}
+				return
+			}
+			if len(conditions) != 1 {
+				t.Fatalf("conditions = %v, want the %s condition", conditions, conditionBridgeSelected)
+			}
+			cond := conditions[0].(map[string]interface{})
+			if cond["type"] != conditionBridgeSelected || cond["reason"] != tt.reason || cond["message"] != tt.message {
+				t.Errorf("condition = %v, want the reason %q and the message %q", cond, tt.reason, tt.message)
+			}
+		})
+	}
+}
@@ -0,0 +1,285 @@
+package watcher
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic/dynamicinformer"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/zmalik/kudo-bridge/bridge-controller/pkg/apis/kudobridge/v1alpha1"
+	bridgeinformers "github.com/zmalik/kudo-bridge/bridge-controller/pkg/generated/informers/externalversions/kudobridge/v1alpha1"
+	"github.com/zmalik/kudo-bridge/crd-controller/pkg/client"
+)
+
+// bridgeResync is the resync period of the BridgeInstances, it restarts the controllers which failed to start
+const bridgeResync = time.Minute
+
+// SharedManager processes the CRD objects of all the BridgeInstances in a single crd-controller.
+// A Controller is started per BridgeInstance, and stopped once the BridgeInstance is deleted or bridges another kind.
+// The CRD objects of a GroupVersionResource are watched once by a cluster wide dynamic informer, its events are
+// dispatched to the controllers of the resource and it is stopped with its last controller. The CRDs are watched
+// once too, their changes are dispatched to the controllers of their kind.
+type SharedManager struct {
+	client        *client.Client
+	workers       int
+	maxRetries    int
+	retryDelay    time.Duration
+	maxRetryDelay time.Duration
+
+	bridgeInformer        cache.SharedIndexInformer
+	clusterBridgeInformer cache.SharedIndexInformer
+	crdInformer           cache.SharedIndexInformer
+
+	lock        sync.Mutex
+	controllers map[controllerKey]*sharedController
+	informers   map[schema.GroupVersionResource]*resourceInformer
+	// crdWatchers are notified of the changes of the CRD of their kind
+	crdWatchers map[schema.GroupKind]map[*Controller]func()
+}
+
+// controllerKey identifies the Controller of a bridge, namespace is the namespace of the BridgeInstance and of the
+// KUDO Instances, the TargetNamespace of a ClusterBridgeInstance
+type controllerKey struct {
+	gvk       schema.GroupVersionKind
+	namespace string
+	bridge    string
+}
+
+type sharedController struct {
+	cancel context.CancelFunc
+}
+
+// resourceInformer is the informer of a GroupVersionResource, the controllers are indexed by the identity of their
+// bridge as several bridges can bridge the same resource in a namespace
+type resourceInformer struct {
+	informer    cache.SharedIndexInformer
+	stopCh      chan struct{}
+	controllers map[string]*Controller
+}
+
+// NewSharedManager returns the manager of the controllers of all the BridgeInstances, the controllers are
+// configured like the ones returned by NewController
+func NewSharedManager(client *client.Client, workers, maxRetries int, retryDelay, maxRetryDelay time.Duration) *SharedManager {
+	return &SharedManager{
+		client:        client,
+		workers:       workers,
+		maxRetries:    maxRetries,
+		retryDelay:    retryDelay,
+		maxRetryDelay: maxRetryDelay,
+		controllers:   make(map[controllerKey]*sharedController),
+		informers:     make(map[schema.GroupVersionResource]*resourceInformer),
+		crdWatchers:   make(map[schema.GroupKind]map[*Controller]func()),
+	}
+}
+
+// Run starts and stops the controllers with the BridgeInstances until the context is done
+func (m *SharedManager) Run(ctx context.Context) {
+	m.bridgeInformer = bridgeinformers.NewBridgeInstanceInformer(m.client.Bridge, metav1.NamespaceAll, bridgeResync, cache.Indexers{})
+	m.clusterBridgeInformer = bridgeinformers.NewClusterBridgeInstanceInformer(m.client.Bridge, bridgeResync, cache.Indexers{})
+	m.crdInformer = newCRDInformer(m.client, m.notifyCRD)
+	handler := cache.ResourceEventHandlerFuncs{
+		AddFunc:    func(obj interface{}) { m.sync(ctx) },
+		UpdateFunc: func(old, new interface{}) { m.sync(ctx) },
+		DeleteFunc: func(obj interface{}) { m.sync(ctx) },
+	}
+	m.bridgeInformer.AddEventHandler(handler)
+	m.clusterBridgeInformer.AddEventHandler(handler)
+
+	go m.bridgeInformer.Run(ctx.Done())
+	go m.clusterBridgeInformer.Run(ctx.Done())
+	go m.crdInformer.Run(ctx.Done())
+
+	log.Infoln("Shared controller started.")
+	if !cache.WaitForCacheSync(ctx.Done(), m.bridgeInformer.HasSynced, m.clusterBridgeInformer.HasSynced, m.crdInformer.HasSynced) {
+		log.Errorf("timed out waiting for caches to sync")
+		return
+	}
+	log.Infoln("Shared controller synced.")
+	m.sync(ctx)
+	<-ctx.Done()
+}
+
+// sync starts the controllers of the bridged kinds and stops the ones no BridgeInstance bridges anymore
+func (m *SharedManager) sync(ctx context.Context) {
+	desired := make(map[controllerKey]bool)
+	bridge := func(crdSpec schema.GroupVersionKind, namespace, name string) {
+		if crdSpec.Version == "" || crdSpec.Kind == "" || namespace == "" {
+			// reported in the BridgeInstance status by the bridge-controller
+			return
+		}
+		desired[controllerKey{gvk: crdSpec, namespace: namespace, bridge: name}] = true
+	}
+	for _, obj := range m.bridgeInformer.GetStore().List() {
+		if bi, ok := obj.(*v1alpha1.BridgeInstance); ok {
+			bridge(bi.Spec.CRDSpec.GroupVersionKind(), bi.GetNamespace(), bi.GetName())
+		}
+	}
+	for _, obj := range m.clusterBridgeInformer.GetStore().List() {
+		if cbi, ok := obj.(*v1alpha1.ClusterBridgeInstance); ok {
+			bridge(cbi.Spec.CRDSpec.GroupVersionKind(), cbi.Spec.TargetNamespace, cbi.GetName())
+		}
+	}
+
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	for key, sc := range m.controllers {
+		if !desired[key] {
+			log.Infof("stopping the controller of %s for the bridge %s/%s", key.gvk, key.namespace, key.bridge)
+			sc.cancel()
+			delete(m.controllers, key)
+		}
+	}
+	for key := range desired {
+		if _, running := m.controllers[key]; !running {
+			m.start(ctx, key)
+		}
+	}
+}
+
+// start runs the controller of the bridge, it is forgotten when it stops on its own so that the next sync
+// restarts it
+func (m *SharedManager) start(ctx context.Context, key controllerKey) {
+	log.Infof("starting the controller of %s for the bridge %s/%s", key.gvk, key.namespace, key.bridge)
+	c := NewController(m.client, key.gvk.GroupVersion().String(), key.gvk.Kind, key.namespace, key.bridge, m.workers, m.maxRetries, m.retryDelay, m.maxRetryDelay)
+	c.shared = m
+	controllerCtx, cancel := context.WithCancel(ctx)
+	sc := &sharedController{cancel: cancel}
+	m.controllers[key] = sc
+
+	go func() {
+		if err := c.Run(controllerCtx); err != nil {
+			log.Errorf("Error running the controller of %s for the bridge %s/%s: %v", key.gvk, key.namespace, key.bridge, err)
+		}
+		cancel()
+		m.lock.Lock()
+		defer m.lock.Unlock()
+		if m.controllers[key] == sc {
+			delete(m.controllers, key)
+		}
+	}()
+}
+
+// acquire returns the informer of the controller resource, the informer is started by its first controller
+func (m *SharedManager) acquire(c *Controller) cache.SharedIndexInformer {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	r, ok := m.informers[c.resource]
+	if !ok {
+		r = &resourceInformer{
+			informer:    dynamicinformer.NewFilteredDynamicInformer(m.client.Dynamic, c.resource, metav1.NamespaceAll, 0, cache.Indexers{instanceNameIndex: indexByInstanceName}, nil).Informer(),
+			stopCh:      make(chan struct{}),
+			controllers: make(map[string]*Controller),
+		}
+		r.informer.AddEventHandler(m.dispatcher(c.resource))
+		m.informers[c.resource] = r
+		go r.informer.Run(r.stopCh)
+		log.Infof("started the informer of %s", c.resource)
+	}
+	r.controllers[bridgeKey(c)] = c
+	return r.informer
+}
+
+// release removes the controller from the informer of its resource, the informer is stopped with its last controller
+func (m *SharedManager) release(c *Controller) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	r, ok := m.informers[c.resource]
+	if !ok || r.controllers[bridgeKey(c)] != c {
+		return
+	}
+	delete(r.controllers, bridgeKey(c))
+	if len(r.controllers) == 0 {
+		close(r.stopCh)
+		delete(m.informers, c.resource)
+		log.Infof("stopped the informer of %s", c.resource)
+	}
+}
+
+// watchCRD returns the CRD informer, notify is called on the changes of the CRD of the kind until unwatchCRD
+func (m *SharedManager) watchCRD(c *Controller, gk schema.GroupKind, notify func()) cache.SharedIndexInformer {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if _, ok := m.crdWatchers[gk]; !ok {
+		m.crdWatchers[gk] = make(map[*Controller]func())
+	}
+	m.crdWatchers[gk][c] = notify
+	return m.crdInformer
+}
+
+// unwatchCRD stops notifying the controller of the changes of the CRD of the kind
+func (m *SharedManager) unwatchCRD(c *Controller, gk schema.GroupKind) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	delete(m.crdWatchers[gk], c)
+	if len(m.crdWatchers[gk]) == 0 {
+		delete(m.crdWatchers, gk)
+	}
+}
+
+// notifyCRD notifies the controllers of the kind of the changed CRD
+func (m *SharedManager) notifyCRD(gk schema.GroupKind) {
+	m.lock.Lock()
+	var watchers []func()
+	for _, notify := range m.crdWatchers[gk] {
+		watchers = append(watchers, notify)
+	}
+	m.lock.Unlock()
+	for _, notify := range watchers {
+		notify()
+	}
+}
+
+// dispatcher forwards the events of the resource informer to all the controllers watching the object namespace,
+// the controller of the bridge selected for the object processes it
+func (m *SharedManager) dispatcher(resource schema.GroupVersionResource) cache.ResourceEventHandler {
+	controllersFor := func(obj interface{}) []*Controller {
+		key, err := cache.DeletionHandlingMetaNamespaceKeyFunc(obj)
+		if err != nil {
+			return nil
+		}
+		namespace, _, err := cache.SplitMetaNamespaceKey(key)
+		if err != nil {
+			return nil
+		}
+		m.lock.Lock()
+		defer m.lock.Unlock()
+		r, ok := m.informers[resource]
+		if !ok {
+			return nil
+		}
+		var controllers []*Controller
+		for _, c := range r.controllers {
+			if c.watchNamespace == metav1.NamespaceAll || c.watchNamespace == namespace {
+				controllers = append(controllers, c)
+			}
+		}
+		return controllers
+	}
+	return cache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj interface{}) {
+			for _, c := range controllersFor(obj) {
+				c.eventHandler().OnAdd(obj)
+			}
+		},
+		UpdateFunc: func(old, new interface{}) {
+			for _, c := range controllersFor(new) {
+				c.eventHandler().OnUpdate(old, new)
+			}
+		},
+		DeleteFunc: func(obj interface{}) {
+			for _, c := range controllersFor(obj) {
+				c.eventHandler().OnDelete(obj)
+			}
+		},
+	}
+}
+
+// bridgeKey identifies the bridge of the controller in the informer of its resource
+func bridgeKey(c *Controller) string {
+	return c.Namespace + "/" + c.Bridge
+}
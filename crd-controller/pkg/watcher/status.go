@@ -0,0 +1,221 @@
+package watcher
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/kudobuilder/kudo/pkg/apis/kudo/v1beta1"
+	log "github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/zmalik/kudo-bridge/bridge-controller/pkg/apis/kudobridge/v1alpha1"
+	"github.com/zmalik/kudo-bridge/crd-controller/pkg/client"
+	"github.com/zmalik/kudo-bridge/crd-controller/pkg/utils"
+)
+
+const (
+	// conditionValid reports if the CRD object satisfies the BridgeInstance validation rules and version ranges
+	conditionValid = "Valid"
+	// conditionReconciled reports if the CRD object is processed, it is only set once the retries are exhausted
+	conditionReconciled = "Reconciled"
+)
+
+// updateStatus reports the KUDO Instance status in the CRD status using the BridgeInstance StatusMappings
+func updateStatus(client *client.Client, resource schema.GroupVersionResource, crd *unstructured.Unstructured, mappings []v1alpha1.StatusMapping, instance *v1beta1.Instance) error {
+	if len(mappings) == 0 || instance == nil {
+		return nil
+	}
+	instanceObj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(instance)
+	if err != nil {
+		return err
+	}
+	instanceFlatMap, err := utils.Flatten(instanceObj, utils.DefaultTokenizer)
+	if err != nil {
+		return err
+	}
+
+	updated := crd.DeepCopy()
+	for _, m := range mappings {
+		path, err := utils.ParsePath(m.To)
+		if err != nil {
+			return fmt.Errorf("status mapping %s of %s/%s: %v", m.To, crd.GetNamespace(), crd.GetName(), err)
+		}
+		if path[0] != "status" {
+			return fmt.Errorf("status mapping %s doesn't target the status of %s/%s", m.To, crd.GetNamespace(), crd.GetName())
+		}
+		val, ok, err := statusValue(instance, instanceFlatMap, m.From)
+		if err != nil {
+			return fmt.Errorf("status mapping %s of %s/%s: %v", m.From, crd.GetNamespace(), crd.GetName(), err)
+		}
+		if !ok {
+			unstructured.RemoveNestedField(updated.Object, path...)
+			continue
+		}
+		if err := unstructured.SetNestedField(updated.Object, val, path...); err != nil {
+			return fmt.Errorf("cannot set %s of %s/%s: %v", m.To, crd.GetNamespace(), crd.GetName(), err)
+		}
+	}
+	_, err = writeStatus(client, resource, crd, updated)
+	return err
+}
+
+// reportValidation sets the Valid condition of the CRD status from the violated validation rules
+func reportValidation(client *client.Client, resource schema.GroupVersionResource, crd *unstructured.Unstructured, violations []string) (*unstructured.Unstructured, error) {
+	cond := map[string]interface{}{
+		"type":    conditionValid,
+		"status":  string(metav1.ConditionTrue),
+		"reason":  "RulesSatisfied",
+		"message": "",
+	}
+	if len(violations) > 0 {
+		cond["status"] = string(metav1.ConditionFalse)
+		cond["reason"] = "ValidationFailed"
+		cond["message"] = strings.Join(violations, "; ")
+	}
+	updated := crd.DeepCopy()
+	if err := setCondition(updated, cond); err != nil {
+		return nil, fmt.Errorf("cannot set the %s condition of %s/%s: %v", conditionValid, crd.GetNamespace(), crd.GetName(), err)
+	}
+	return writeStatus(client, resource, crd, updated)
+}
+
+// reportFailure sets the Reconciled condition of the CRD status from the error of its last processing
+func reportFailure(client *client.Client, resource schema.GroupVersionResource, crd *unstructured.Unstructured, processErr error) error {
+	cond := map[string]interface{}{
+		"type":    conditionReconciled,
+		"status":  string(metav1.ConditionTrue),
+		"reason":  "Reconciled",
+		"message": "",
+	}
+	if processErr != nil {
+		cond["status"] = string(metav1.ConditionFalse)
+		cond["reason"] = "RetriesExhausted"
+		cond["message"] = processErr.Error()
+	}
+	updated := crd.DeepCopy()
+	if err := setCondition(updated, cond); err != nil {
+		return fmt.Errorf("cannot set the %s condition of %s/%s: %v", conditionReconciled, crd.GetNamespace(), crd.GetName(), err)
+	}
+	_, err := writeStatus(client, resource, crd, updated)
+	return err
+}
+
+// hasFailed returns true when the Reconciled condition of the CRD status reports a failure
+func hasFailed(crd *unstructured.Unstructured) bool {
+	conditions, _, _ := unstructured.NestedSlice(crd.Object, "status", "conditions")
+	for _, c := range conditions {
+		if cond, ok := c.(map[string]interface{}); ok && cond["type"] == conditionReconciled {
+			return cond["status"] == string(metav1.ConditionFalse)
+		}
+	}
+	return false
+}
+
+// setCondition adds or replaces the condition of the same type in status.conditions,
+// the lastTransitionTime is only changed with the condition status
+func setCondition(crd *unstructured.Unstructured, cond map[string]interface{}) error {
+	conditions, _, err := unstructured.NestedSlice(crd.Object, "status", "conditions")
+	if err != nil {
+		return err
+	}
+	cond["observedGeneration"] = crd.GetGeneration()
+	cond["lastTransitionTime"] = metav1.Now().UTC().Format(time.RFC3339)
+	for i, c := range conditions {
+		existing, ok := c.(map[string]interface{})
+		if !ok || existing["type"] != cond["type"] {
+			continue
+		}
+		if existing["status"] == cond["status"] {
+			cond["lastTransitionTime"] = existing["lastTransitionTime"]
+		}
+		conditions[i] = cond
+		return unstructured.SetNestedSlice(crd.Object, conditions, "status", "conditions")
+	}
+	return unstructured.SetNestedSlice(crd.Object, append(conditions, cond), "status", "conditions")
+}
+
+// writeStatus updates the status of the CRD object when it changed and returns the updated object
+func writeStatus(client *client.Client, resource schema.GroupVersionResource, crd, updated *unstructured.Unstructured) (*unstructured.Unstructured, error) {
+	if reflect.DeepEqual(updated.Object["status"], crd.Object["status"]) {
+		return crd, nil
+	}
+
+	log.Infof("updating the status of %s/%s", crd.GetNamespace(), crd.GetName())
+	res, err := client.Dynamic.Resource(resource).Namespace(crd.GetNamespace()).UpdateStatus(context.TODO(), updated, metav1.UpdateOptions{})
+	if errors.IsNotFound(err) {
+		// the CRD doesn't have the status subresource
+		res, err = client.Dynamic.Resource(resource).Namespace(crd.GetNamespace()).Update(context.TODO(), updated, metav1.UpdateOptions{})
+	}
+	return res, err
+}
+
+func statusValue(instance *v1beta1.Instance, instanceFlatMap *utils.Map, from string) (interface{}, bool, error) {
+	switch v1alpha1.StatusSource(from) {
+	case v1alpha1.StatusSourcePlan:
+		if ps := instance.GetLastExecutedPlanStatus(); ps != nil {
+			return ps.Name, true, nil
+		}
+	case v1alpha1.StatusSourcePlanStatus:
+		if ps := instance.GetLastExecutedPlanStatus(); ps != nil {
+			return string(ps.Status), true, nil
+		}
+	case v1alpha1.StatusSourcePhase:
+		if phase := activePhase(instance.GetLastExecutedPlanStatus()); phase != nil {
+			return phase.Name, true, nil
+		}
+	case v1alpha1.StatusSourceLastError:
+		if ps := lastFailedPlan(instance); ps != nil {
+			if ps.Message != "" {
+				return ps.Message, true, nil
+			}
+			return string(ps.Status), true, nil
+		}
+	default:
+		path, err := utils.ParsePath(from)
+		if err != nil {
+			return nil, false, err
+		}
+		if val, ok := instanceFlatMap.Get(utils.DefaultTokenizer.Token(path)); ok {
+			if i, isInt := val.(int); isInt {
+				// collection sizes are the only int values of the flatten map
+				return int64(i), true, nil
+			}
+			return val, true, nil
+		}
+	}
+	return nil, false, nil
+}
+
+// activePhase returns the first unfinished phase of the plan or its last phase
+func activePhase(ps *v1beta1.PlanStatus) *v1beta1.PhaseStatus {
+	if ps == nil || len(ps.Phases) == 0 {
+		return nil
+	}
+	for i := range ps.Phases {
+		if !ps.Phases[i].Status.IsFinished() {
+			return &ps.Phases[i]
+		}
+	}
+	return &ps.Phases[len(ps.Phases)-1]
+}
+
+func lastFailedPlan(instance *v1beta1.Instance) *v1beta1.PlanStatus {
+	var last *v1beta1.PlanStatus
+	for name := range instance.Status.PlanStatus {
+		ps := instance.Status.PlanStatus[name]
+		if ps.Status != v1beta1.ErrorStatus && ps.Status != v1beta1.ExecutionFatalError {
+			continue
+		}
+		if last == nil || (ps.LastUpdatedTimestamp != nil && last.LastUpdatedTimestamp != nil && ps.LastUpdatedTimestamp.After(last.LastUpdatedTimestamp.Time)) {
+			last = &ps
+		}
+	}
+	return last
+}
@@ -0,0 +1,183 @@
+package watcher
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	kudov1beta1 "github.com/kudobuilder/kudo/pkg/apis/kudo/v1beta1"
+	log "github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/zmalik/kudo-bridge/bridge-controller/pkg/apis/kudobridge/v1alpha1"
+	"github.com/zmalik/kudo-bridge/crd-controller/pkg/client"
+	"github.com/zmalik/kudo-bridge/crd-controller/pkg/kudo"
+	"github.com/zmalik/kudo-bridge/crd-controller/pkg/params"
+)
+
+// conditionSuspended reports if the KUDO Instance updates of the CRD object are suspended
+const conditionSuspended = "Suspended"
+
+// suspendReason returns the reason the CRD object is suspended, empty when it isn't
+func suspendReason(crd *unstructured.Unstructured, bi v1alpha1.BridgeInstance) string {
+	if bi.Spec.Suspend {
+		return "BridgeSuspended"
+	}
+	if crd.GetAnnotations()[v1alpha1.SuspendAnnotation] == "true" {
+		return "ObjectSuspended"
+	}
+	return ""
+}
+
+// reportSuspended records the changes the KUDO Instance would get in the Suspended condition of the CRD status
+func reportSuspended(client *client.Client, resource schema.GroupVersionResource, crd *unstructured.Unstructured, bi v1alpha1.BridgeInstance, kc *kudo.KUDOClient, refs params.References, reason string) error {
+	pending, err := pendingChanges(crd, bi, kc, refs)
+	if err != nil {
+		// the changes are only reported, the suspension holds
+		log.Errorf("Error computing the pending changes of %s/%s: %v", crd.GetNamespace(), crd.GetName(), err)
+		pending = []string{fmt.Sprintf("unknown: %v", err)}
+	}
+	message := "no pending changes"
+	if len(pending) > 0 {
+		message = "pending changes: " + strings.Join(pending, ", ")
+	}
+	log.Infof("%s/%s is suspended, %s", crd.GetNamespace(), crd.GetName(), message)
+
+	updated := crd.DeepCopy()
+	cond := map[string]interface{}{
+		"type":    conditionSuspended,
+		"status":  string(metav1.ConditionTrue),
+		"reason":  reason,
+		"message": message,
+	}
+	if err := setCondition(updated, cond); err != nil {
+		return fmt.Errorf("cannot set the %s condition of %s/%s: %v", conditionSuspended, crd.GetNamespace(), crd.GetName(), err)
+	}
+	_, err = writeStatus(client, resource, crd, updated)
+	return err
+}
+
+// reportResumed clears the Suspended condition of a CRD object which was suspended and returns the updated object
+func reportResumed(client *client.Client, resource schema.GroupVersionResource, crd *unstructured.Unstructured) (*unstructured.Unstructured, error) {
+	conditions, _, _ := unstructured.NestedSlice(crd.Object, "status", "conditions")
+	suspended := false
+	for _, c := range conditions {
+		if cond, ok := c.(map[string]interface{}); ok && cond["type"] == conditionSuspended && cond["status"] == string(metav1.ConditionTrue) {
+			suspended = true
+		}
+	}
+	if !suspended {
+		return crd, nil
+	}
+
+	log.Infof("%s/%s is resumed, applying its latest state", crd.GetNamespace(), crd.GetName())
+	updated := crd.DeepCopy()
+	cond := map[string]interface{}{
+		"type":    conditionSuspended,
+		"status":  string(metav1.ConditionFalse),
+		"reason":  "Resumed",
+		"message": "",
+	}
+	if err := setCondition(updated, cond); err != nil {
+		return nil, fmt.Errorf("cannot set the %s condition of %s/%s: %v", conditionSuspended, crd.GetNamespace(), crd.GetName(), err)
+	}
+	return writeStatus(client, resource, crd, updated)
+}
+
+// pendingChanges returns the operator version and the parameters the KUDO Instance would be updated with, or the
+// deletion policy applied to it once the CRD object is deleted, without installing or deleting anything
+func pendingChanges(crd *unstructured.Unstructured, bi v1alpha1.BridgeInstance, kc *kudo.KUDOClient, refs params.References) ([]string, error) {
+	instance, err := kc.GetInstance(crd)
+	if err != nil {
+		return nil, err
+	}
+	if !crd.GetDeletionTimestamp().IsZero() {
+		if instance == nil || !ownedBy(instance, crd) {
+			return []string{"deletion of the object"}, nil
+		}
+		policy := bi.Spec.DeletionPolicy
+		if policy == "" {
+			policy = v1alpha1.DeletionPolicyDelete
+		}
+		return []string{fmt.Sprintf("deletion of the object with the %s deletion policy of the KUDO Instance", policy)}, nil
+	}
+	if instance == nil {
+		return []string{"install of the KUDO Instance"}, nil
+	}
+	ov, err := kc.GetOperatorVersion(instance)
+	if err != nil {
+		return nil, err
+	}
+	if ov == nil {
+		return nil, fmt.Errorf("no OperatorVersion installed for Instance %s/%s", instance.GetNamespace(), instance.GetName())
+	}
+
+	var pending []string
+	if !kc.Matches(ov) || bi.Spec.KUDOOperator.Version == "" {
+		// the versions left empty in the bridge are resolved from the repository
+		resolved, err := kc.ResolveOV()
+		if err != nil {
+			return nil, err
+		}
+		pending = append(pending, versionDiff(ov, resolved)...)
+	}
+	desired, err := params.Resolve(crd, bi, ov, refs)
+	if err != nil {
+		return nil, err
+	}
+	return append(pending, parameterDiff(instance.Spec.Parameters, desired, referencedParams(bi))...), nil
+}
+
+// versionDiff returns the changes of the operator version and app version from the installed OperatorVersion
+// to the resolved one
+func versionDiff(installed, resolved *kudov1beta1.OperatorVersion) []string {
+	var diff []string
+	if installed.Spec.Version != resolved.Spec.Version {
+		diff = append(diff, fmt.Sprintf("operator version %s -> %s", installed.Spec.Version, resolved.Spec.Version))
+	}
+	if installed.Spec.AppVersion != resolved.Spec.AppVersion {
+		diff = append(diff, fmt.Sprintf("app version %s -> %s", installed.Spec.AppVersion, resolved.Spec.AppVersion))
+	}
+	return diff
+}
+
+// parameterDiff returns the added, changed and removed parameters sorted by name, the values of the redacted
+// parameters are not shown
+func parameterDiff(current, desired map[string]string, redacted map[string]bool) []string {
+	var diff []string
+	for name := range current {
+		// the removed parameters may have been mapped from a Secret, their values are never shown
+		if _, exists := desired[name]; !exists {
+			diff = append(diff, fmt.Sprintf("%s removed", name))
+		}
+	}
+	for name, val := range desired {
+		old, exists := current[name]
+		if exists && old == val {
+			continue
+		}
+		switch {
+		case redacted[name]:
+			diff = append(diff, fmt.Sprintf("%s changed", name))
+		case !exists:
+			diff = append(diff, fmt.Sprintf("%s: <unset> -> %s", name, val))
+		default:
+			diff = append(diff, fmt.Sprintf("%s: %s -> %s", name, old, val))
+		}
+	}
+	sort.Strings(diff)
+	return diff
+}
+
+// referencedParams returns the parameters mapped from Secrets and ConfigMaps
+func referencedParams(bi v1alpha1.BridgeInstance) map[string]bool {
+	referenced := make(map[string]bool)
+	for _, m := range bi.Spec.ParameterMappings {
+		if m.Reference != "" {
+			referenced[m.To] = true
+		}
+	}
+	return referenced
+}
@@ -0,0 +1,125 @@
+package watcher
+
+import (
+	"reflect"
+	"testing"
+
+	kudov1beta1 "github.com/kudobuilder/kudo/pkg/apis/kudo/v1beta1"
+
+	"github.com/zmalik/kudo-bridge/bridge-controller/pkg/apis/kudobridge/v1alpha1"
+)
+
+func TestParameterDiff(t *testing.T) {
+	tests := []struct {
+		name     string
+		current  map[string]string
+		desired  map[string]string
+		redacted map[string]bool
+		want     []string
+	}{
+		{name: "no parameters"},
+		{
+			name:    "unchanged",
+			current: map[string]string{"NODE_COUNT": "3", "MEMORY": "1Gi"},
+			desired: map[string]string{"NODE_COUNT": "3", "MEMORY": "1Gi"},
+		},
+		{
+			name:    "changed",
+			current: map[string]string{"NODE_COUNT": "3", "MEMORY": "1Gi"},
+			desired: map[string]string{"NODE_COUNT": "5", "MEMORY": "1Gi"},
+			want:    []string{"NODE_COUNT: 3 -> 5"},
+		},
+		{
+			name:    "added",
+			current: map[string]string{"NODE_COUNT": "3"},
+			desired: map[string]string{"NODE_COUNT": "3", "MEMORY": "2Gi"},
+			want:    []string{"MEMORY: <unset> -> 2Gi"},
+		},
+		{
+			name:    "removed",
+			current: map[string]string{"NODE_COUNT": "3", "MEMORY": "1Gi"},
+			desired: map[string]string{"NODE_COUNT": "3"},
+			want:    []string{"MEMORY removed"},
+		},
+		{
+			name:     "redacted",
+			current:  map[string]string{"PASSWORD": "old", "USER": "admin"},
+			desired:  map[string]string{"PASSWORD": "new", "TOKEN": "secret"},
+			redacted: map[string]bool{"PASSWORD": true, "TOKEN": true, "USER": true},
+			want:     []string{"PASSWORD changed", "TOKEN changed", "USER removed"},
+		},
+		{
+			name:    "sorted by name",
+			current: map[string]string{"C": "1", "A": "1", "D": "1"},
+			desired: map[string]string{"C": "2", "B": "1", "A": "2"},
+			want:    []string{"A: 1 -> 2", "B: <unset> -> 1", "C: 1 -> 2", "D removed"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := parameterDiff(tt.current, tt.desired, tt.redacted); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("parameterDiff() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVersionDiff(t *testing.T) {
+	ov := func(version, appVersion string) *kudov1beta1.OperatorVersion {
+		return &kudov1beta1.OperatorVersion{Spec: kudov1beta1.OperatorVersionSpec{Version: version, AppVersion: appVersion}}
+	}
+
+	tests := []struct {
+		name      string
+		installed *kudov1beta1.OperatorVersion
+		resolved  *kudov1beta1.OperatorVersion
+		want      []string
+	}{
+		{name: "same versions", installed: ov("0.2.0", "5.0.1"), resolved: ov("0.2.0", "5.0.1")},
+		{name: "operator version", installed: ov("0.2.0", "5.0.1"), resolved: ov("0.3.0", "5.0.1"), want: []string{"operator version 0.2.0 -> 0.3.0"}},
+		{name: "app version", installed: ov("0.2.0", "5.0.1"), resolved: ov("0.2.0", "5.0.3"), want: []string{"app version 5.0.1 -> 5.0.3"}},
+		{
+			name:      "both versions",
+			installed: ov("0.2.0", "5.0.1"),
+			resolved:  ov("0.3.0", "6.0.0"),
+			want:      []string{"operator version 0.2.0 -> 0.3.0", "app version 5.0.1 -> 6.0.0"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := versionDiff(tt.installed, tt.resolved); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("versionDiff() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSuspendReason(t *testing.T) {
+	tests := []struct {
+		name       string
+		suspend    bool
+		annotation string
+		want       string
+	}{
+		{name: "not suspended"},
+		{name: "bridge suspended", suspend: true, want: "BridgeSuspended"},
+		{name: "object suspended", annotation: "true", want: "ObjectSuspended"},
+		{name: "both suspended", suspend: true, annotation: "true", want: "BridgeSuspended"},
+		{name: "annotation not true", annotation: "yes"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			crd := redis("dev", "a", nil)
+			if tt.annotation != "" {
+				crd.SetAnnotations(map[string]string{v1alpha1.SuspendAnnotation: tt.annotation})
+			}
+			bi := v1alpha1.BridgeInstance{Spec: v1alpha1.BridgeInstanceSpec{Suspend: tt.suspend}}
+			if got := suspendReason(crd, bi); got != tt.want {
+				t.Errorf("suspendReason() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,97 @@
+package watcher
+
+import (
+	"fmt"
+
+	"github.com/Masterminds/semver"
+	kudov1beta1 "github.com/kudobuilder/kudo/pkg/apis/kudo/v1beta1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/zmalik/kudo-bridge/bridge-controller/pkg/apis/kudobridge/v1alpha1"
+	"github.com/zmalik/kudo-bridge/crd-controller/pkg/kudo"
+)
+
+// pinsVersion returns true when the CRD object overrides the KUDO Operator versions of its bridge
+func pinsVersion(crd *unstructured.Unstructured) bool {
+	_, version := crd.GetAnnotations()[v1alpha1.OperatorVersionAnnotation]
+	_, appVersion := crd.GetAnnotations()[v1alpha1.AppVersionAnnotation]
+	return version || appVersion
+}
+
+// pinnedOperator returns the KUDO Operator of the bridge with the versions pinned by the CRD object annotations,
+// the annotations outside of the ranges allowed by the bridge are returned as violations
+func pinnedOperator(crd *unstructured.Unstructured, op v1alpha1.KUDOOperator) (v1alpha1.KUDOOperator, []string) {
+	var violations []string
+	if version, ok := crd.GetAnnotations()[v1alpha1.OperatorVersionAnnotation]; ok {
+		if err := checkVersion(version, op.AllowedVersions); err != nil {
+			violations = append(violations, fmt.Sprintf("annotation %s: %v", v1alpha1.OperatorVersionAnnotation, err))
+		} else {
+			op.Version = version
+		}
+	}
+	if appVersion, ok := crd.GetAnnotations()[v1alpha1.AppVersionAnnotation]; ok {
+		if err := checkVersion(appVersion, op.AllowedAppVersions); err != nil {
+			violations = append(violations, fmt.Sprintf("annotation %s: %v", v1alpha1.AppVersionAnnotation, err))
+		} else {
+			op.AppVersion = appVersion
+		}
+	}
+	return op, violations
+}
+
+// checkVersion returns an error when the version doesn't satisfy the semver range, no version is allowed
+// without range
+func checkVersion(version, allowed string) error {
+	if allowed == "" {
+		return fmt.Errorf("the bridge doesn't allow pinning the version")
+	}
+	constraint, err := semver.NewConstraint(allowed)
+	if err != nil {
+		return fmt.Errorf("invalid allowed range %s of the bridge: %v", allowed, err)
+	}
+	v, err := semver.NewVersion(version)
+	if err != nil {
+		return fmt.Errorf("invalid version %s: %v", version, err)
+	}
+	if !constraint.Check(v) {
+		return fmt.Errorf("version %s is not in the allowed range %s", version, allowed)
+	}
+	return nil
+}
+
+// downgradeViolations returns the pinned versions the installed KUDO Instance can't be moved to, KUDO only upgrades
+// an Instance to a higher operator version. The app version pinned without operator version is only known once
+// resolved, its downgrade fails the processing of the CRD object.
+func downgradeViolations(crd *unstructured.Unstructured, op v1alpha1.KUDOOperator, kc *kudo.KUDOClient) ([]string, error) {
+	instance, err := kc.GetInstance(crd)
+	if err != nil || instance == nil || op.Version == "" {
+		return nil, err
+	}
+	ov, err := kc.GetOperatorVersion(instance)
+	if err != nil || ov == nil || kc.Matches(ov) {
+		return nil, err
+	}
+	return downgrades(instance, ov, op.Version), nil
+}
+
+// downgrades returns the violation of pinning the KUDO Instance running the OperatorVersion to the version, none when
+// the version is higher or one of the versions isn't semver
+func downgrades(instance *kudov1beta1.Instance, ov *kudov1beta1.OperatorVersion, version string) []string {
+	installed, err := semver.NewVersion(ov.Spec.Version)
+	if err != nil {
+		return nil
+	}
+	pinned, err := semver.NewVersion(version)
+	if err != nil {
+		return nil
+	}
+	switch {
+	case pinned.LessThan(installed):
+		return []string{fmt.Sprintf("the KUDO Instance %s/%s runs the version %s, KUDO doesn't downgrade it to %s",
+			instance.GetNamespace(), instance.GetName(), ov.Spec.Version, version)}
+	case pinned.Equal(installed):
+		return []string{fmt.Sprintf("the KUDO Instance %s/%s runs the version %s of the app version %s, KUDO only upgrades it to a higher version",
+			instance.GetNamespace(), instance.GetName(), ov.Spec.Version, ov.Spec.AppVersion)}
+	}
+	return nil
+}
@@ -0,0 +1,131 @@
+package watcher
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	kudov1beta1 "github.com/kudobuilder/kudo/pkg/apis/kudo/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/zmalik/kudo-bridge/bridge-controller/pkg/apis/kudobridge/v1alpha1"
+)
+
+func TestCheckVersion(t *testing.T) {
+	tests := []struct {
+		version string
+		allowed string
+		err     string
+	}{
+		{version: "0.2.0", allowed: ">= 0.1.0", err: ""},
+		{version: "0.2.0", allowed: ">=0.1.0, <0.3.0", err: ""},
+		{version: "5.0.7", allowed: "~5.0", err: ""},
+		{version: "0.3.0", allowed: ">=0.1.0, <0.3.0", err: "version 0.3.0 is not in the allowed range >=0.1.0, <0.3.0"},
+		{version: "5.1.0", allowed: "~5.0", err: "version 5.1.0 is not in the allowed range ~5.0"},
+		{version: "0.2.0", allowed: "", err: "the bridge doesn't allow pinning the version"},
+		{version: "latest", allowed: ">= 0.1.0", err: "invalid version latest: "},
+		{version: "0.2.0", allowed: "not a range", err: "invalid allowed range not a range of the bridge: "},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.version+" "+tt.allowed, func(t *testing.T) {
+			err := checkVersion(tt.version, tt.allowed)
+			if tt.err == "" {
+				if err != nil {
+					t.Errorf("checkVersion(%q, %q) = %v, want no error", tt.version, tt.allowed, err)
+				}
+				return
+			}
+			if err == nil || !strings.HasPrefix(err.Error(), tt.err) {
+				t.Errorf("checkVersion(%q, %q) = %v, want an error starting with %q", tt.version, tt.allowed, err, tt.err)
+			}
+		})
+	}
+}
+
+func TestPinnedOperator(t *testing.T) {
+	op := v1alpha1.KUDOOperator{
+		Package:            "redis",
+		Version:            "0.2.0",
+		AppVersion:         "5.0.1",
+		AllowedVersions:    ">=0.1.0, <0.3.0",
+		AllowedAppVersions: "~5.0",
+	}
+
+	tests := []struct {
+		name        string
+		annotations map[string]string
+		version     string
+		appVersion  string
+		violations  int
+	}{
+		{name: "no annotations", version: "0.2.0", appVersion: "5.0.1"},
+		{
+			name:        "both versions pinned",
+			annotations: map[string]string{v1alpha1.OperatorVersionAnnotation: "0.1.0", v1alpha1.AppVersionAnnotation: "5.0.3"},
+			version:     "0.1.0",
+			appVersion:  "5.0.3",
+		},
+		{
+			name:        "version out of range",
+			annotations: map[string]string{v1alpha1.OperatorVersionAnnotation: "0.3.0", v1alpha1.AppVersionAnnotation: "5.0.3"},
+			version:     "0.2.0",
+			appVersion:  "5.0.3",
+			violations:  1,
+		},
+		{
+			name:        "both versions out of range",
+			annotations: map[string]string{v1alpha1.OperatorVersionAnnotation: "1.0.0", v1alpha1.AppVersionAnnotation: "6.0.0"},
+			version:     "0.2.0",
+			appVersion:  "5.0.1",
+			violations:  2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			crd := redis("dev", "a", nil)
+			crd.SetAnnotations(tt.annotations)
+			if pinsVersion(crd) != (len(tt.annotations) > 0) {
+				t.Errorf("pinsVersion() = %v with the annotations %v", pinsVersion(crd), tt.annotations)
+			}
+			got, violations := pinnedOperator(crd, op)
+			if got.Version != tt.version || got.AppVersion != tt.appVersion {
+				t.Errorf("pinnedOperator() versions = %s %s, want %s %s", got.Version, got.AppVersion, tt.version, tt.appVersion)
+			}
+			if len(violations) != tt.violations {
+				t.Errorf("pinnedOperator() violations = %q, want %d", violations, tt.violations)
+			}
+		})
+	}
+}
+
+func TestDowngrades(t *testing.T) {
+	instance := &kudov1beta1.Instance{ObjectMeta: metav1.ObjectMeta{Namespace: "dev", Name: "redis"}}
+	ov := &kudov1beta1.OperatorVersion{Spec: kudov1beta1.OperatorVersionSpec{Version: "0.2.0", AppVersion: "5.0.1"}}
+
+	tests := []struct {
+		version string
+		want    []string
+	}{
+		{version: "0.3.0"},
+		{version: "0.2.1"},
+		{version: "0.1.0", want: []string{"the KUDO Instance dev/redis runs the version 0.2.0, KUDO doesn't downgrade it to 0.1.0"}},
+		{version: "0.2.0", want: []string{"the KUDO Instance dev/redis runs the version 0.2.0 of the app version 5.0.1, KUDO only upgrades it to a higher version"}},
+		{version: "v0.2.0", want: []string{"the KUDO Instance dev/redis runs the version 0.2.0 of the app version 5.0.1, KUDO only upgrades it to a higher version"}},
+		{version: "latest"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.version, func(t *testing.T) {
+			if got := downgrades(instance, ov, tt.version); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("downgrades(%q) = %q, want %q", tt.version, got, tt.want)
+			}
+		})
+	}
+
+	invalid := &kudov1beta1.OperatorVersion{Spec: kudov1beta1.OperatorVersionSpec{Version: "dev"}}
+	if got := downgrades(instance, invalid, "0.1.0"); got != nil {
+		t.Errorf("downgrades() of a non semver OperatorVersion = %q, want none", got)
+	}
+}
@@ -32,7 +32,7 @@ cd "${FAKE_REPOPATH}"
 "${CODE_GEN_DIR}"/generate-groups.sh \
  all \
   github.com/zmalik/kudo-bridge/bridge-controller/pkg/generated github.com/zmalik/kudo-bridge/bridge-controller/pkg/apis \
-  "kudobridge:v1alpha1" \
+  "kudobridge:v1alpha1,v1beta1" \
   --go-header-file hack/boilerplate.go.txt # must be last for some reaso
 
 # To use your own boilerplate text append: